@@ -0,0 +1,134 @@
+package cfg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Answers holds pre-filled answers to the project creation prompts,
+// given as flags on gost create/init/new or through an --answers file:
+//
+//	backend: chi
+//	db: postgresql
+//	orm: bun
+//	ui: tailwindcss
+//	port: 8080
+//
+// JSON answer files work as well. Empty fields are still asked for.
+type Answers struct {
+	IDE            string `yaml:"ide,omitempty" json:"ide,omitempty" toml:"ide,omitempty"`
+	Backend        string `yaml:"backend,omitempty" json:"backend,omitempty" toml:"backend,omitempty"`
+	Db             string `yaml:"db,omitempty" json:"db,omitempty" toml:"db,omitempty"`
+	Orm            string `yaml:"orm,omitempty" json:"orm,omitempty" toml:"orm,omitempty"`
+	Frontend       string `yaml:"frontend,omitempty" json:"frontend,omitempty" toml:"frontend,omitempty"`
+	Ui             string `yaml:"ui,omitempty" json:"ui,omitempty" toml:"ui,omitempty"`
+	Components     string `yaml:"components,omitempty" json:"components,omitempty" toml:"components,omitempty"`
+	Port           int    `yaml:"port,omitempty" json:"port,omitempty" toml:"port,omitempty"`
+	GlobalSettings string `yaml:"global-settings,omitempty" json:"global-settings,omitempty" toml:"global-settings,omitempty"`
+	ConfigFormat   string `yaml:"config-format,omitempty" json:"config-format,omitempty" toml:"config-format,omitempty"`
+}
+
+// LoadAnswers reads an answers file, unknown keys are rejected so typos don't go unnoticed.
+func LoadAnswers(filePath string) (Answers, error) {
+	var answers Answers
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return answers, err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&answers); err != nil && !errors.Is(err, io.EOF) {
+		return answers, fmt.Errorf(">>Gost>> invalid answers file %s: %w", filePath, err)
+	}
+	return answers, nil
+}
+
+// Merge returns a copy of answers where the non empty fields of other win.
+func (answers Answers) Merge(other Answers) Answers {
+	pick := func(a, b string) string {
+		if b != "" {
+			return b
+		}
+		return a
+	}
+	merged := Answers{
+		IDE:            pick(answers.IDE, other.IDE),
+		Backend:        pick(answers.Backend, other.Backend),
+		Db:             pick(answers.Db, other.Db),
+		Orm:            pick(answers.Orm, other.Orm),
+		Frontend:       pick(answers.Frontend, other.Frontend),
+		Ui:             pick(answers.Ui, other.Ui),
+		Components:     pick(answers.Components, other.Components),
+		Port:           answers.Port,
+		GlobalSettings: pick(answers.GlobalSettings, other.GlobalSettings),
+		ConfigFormat:   pick(answers.ConfigFormat, other.ConfigFormat),
+	}
+	if other.Port != 0 {
+		merged.Port = other.Port
+	}
+	return merged
+}
+
+// Validate checks every answer against its choices and rewrites it to the canonical spelling, e.g. chi to Chi.
+func (answers *Answers) Validate() error {
+	fields := []struct {
+		name    string
+		value   *string
+		choices []string
+	}{
+		{"ide", &answers.IDE, IDEs},
+		{"backend", &answers.Backend, BackendFrameworks},
+		{"db", &answers.Db, DbDrivers},
+		{"orm", &answers.Orm, DbOrms},
+		{"frontend", &answers.Frontend, FrontEndFrameworks},
+		{"ui", &answers.Ui, UiFrameworks},
+		{"components", &answers.Components, ComponentsFrameworks},
+		{"global-settings", &answers.GlobalSettings, GlobalSettingsModes},
+		{"config-format", &answers.ConfigFormat, ConfigFormats},
+	}
+	var errs []error
+	for _, field := range fields {
+		if *field.value == "" {
+			continue
+		}
+		choice, err := MatchChoice(field.name, *field.value, field.choices)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		*field.value = choice
+	}
+	if answers.Port != 0 {
+		errs = append(errs, ValidatePort(answers.Port))
+	}
+	if answers.Components != "" && answers.Components != "None" && answers.Ui != "" && answers.Ui != "Tailwindcss" {
+		errs = append(errs, fmt.Errorf(">>Gost>> components %s requires the Tailwindcss ui", answers.Components))
+	}
+	return errors.Join(errs...)
+}
+
+// Apply copies the non empty answers onto config.
+func (answers Answers) Apply(config *GostConfig) {
+	set := func(field *string, value string) {
+		if value != "" {
+			*field = value
+		}
+	}
+	set(&config.PreferredIDE, answers.IDE)
+	set(&config.PreferredBackendFramework, answers.Backend)
+	set(&config.PreferredDbDriver, answers.Db)
+	set(&config.PreferredDbOrm, answers.Orm)
+	set(&config.PreferredFrontEndFramework, answers.Frontend)
+	set(&config.PreferredUiFramework, answers.Ui)
+	set(&config.PreferredComponentsFramework, answers.Components)
+	set(&config.GlobalSettings, answers.GlobalSettings)
+	set(&config.PreferredConfigFormat, answers.ConfigFormat)
+	if answers.Port != 0 {
+		config.PreferredPort = answers.Port
+	}
+}
@@ -0,0 +1,49 @@
+package cfg
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnswersValidateNormalizesChoices(t *testing.T) {
+	answers := Answers{Backend: "chi", Db: "postgresql", Orm: "builtin", Ui: "tailwindcss", Components: "tw-elements", Port: 8080, ConfigFormat: "YAML"}
+	assert.NoError(t, answers.Validate())
+	assert.Equal(t, Answers{Backend: "Chi", Db: "Postgresql", Orm: "Built In", Ui: "Tailwindcss", Components: "TW-Elements", Port: 8080, ConfigFormat: "yaml"}, answers)
+
+	invalid := Answers{Backend: "rails", Port: 70000}
+	err := invalid.Validate()
+	assert.ErrorContains(t, err, `invalid backend "rails"`)
+	assert.ErrorContains(t, err, "invalid port 70000")
+
+	bootstrap := Answers{Ui: "bootstrap", Components: "daisyui"}
+	assert.ErrorContains(t, bootstrap.Validate(), "requires the Tailwindcss ui")
+}
+
+func TestLoadAnswersAndMerge(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "answers.yaml")
+	createTestFile(t, filePath, "backend: gin\ndb: sqlite\nport: 9000\n")
+
+	answers, err := LoadAnswers(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, Answers{Backend: "gin", Db: "sqlite", Port: 9000}, answers)
+
+	merged := answers.Merge(Answers{Backend: "echo", Orm: "bun"})
+	assert.Equal(t, Answers{Backend: "echo", Db: "sqlite", Orm: "bun", Port: 9000}, merged)
+
+	config := &GostConfig{PreferredBackendFramework: "Gin", PreferredIDE: "Vim"}
+	merged.Apply(config)
+	assert.Equal(t, "echo", config.PreferredBackendFramework)
+	assert.Equal(t, "Vim", config.PreferredIDE)
+	assert.Equal(t, 9000, config.PreferredPort)
+
+	createTestFile(t, filePath, "backnd: gin\n")
+	_, err = LoadAnswers(filePath)
+	assert.Error(t, err)
+
+	createTestFile(t, filePath, `{"backend": "chi", "ui": "bootstrap"}`)
+	answers, err = LoadAnswers(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, Answers{Backend: "chi", Ui: "bootstrap"}, answers)
+}
@@ -0,0 +1,43 @@
+package cfg
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The choices offered when building a GostConfig, the first choice of each list is its default.
+var (
+	IDEs                 = []string{"VSCode", "Goland", "IDEA", "Cursor", "Zed", "Sublime", "Vim", "Nvim", "Nano", "Notepad++", "Zeus", "LiteIDE", "Emacs", "Eclipse"}
+	BackendFrameworks    = []string{"Gin", "Chi", "Echo", "Fiber", "StdLib"}
+	DbDrivers            = []string{"Sqlite", "Postgresql", "MySql", "MongoDb"}
+	DbOrms               = []string{"Built In", "Ent", "Gorm", "Bun", "Sqlc", "Bob"}
+	FrontEndFrameworks   = []string{"Htmx", "React", "Svelte", "Vue"}
+	UiFrameworks         = []string{"Tailwindcss", "Bootstrap"}
+	ComponentsFrameworks = []string{"None", "DaisyUI", "Flowbite", "PrelineUI", "TW-Elements"}
+	Ports                = []int{9630, 42069, 6666, 8080}
+	GlobalSettingsModes  = []string{"Yes ask me", "No set it & forget it.", "Keep IDE settings only global.", "Keep IDE & port settings only global."}
+	ConfigFormats        = []string{"env", "json", "toml", "yaml"}
+)
+
+// MatchChoice returns the choice matching value case-insensitively, ignoring spaces, dashes and dots,
+// so "builtin" matches "Built In" and "tw-elements" matches "TW-Elements".
+func MatchChoice(name, value string, choices []string) (string, error) {
+	for _, choice := range choices {
+		if normalizeChoice(choice) == normalizeChoice(value) {
+			return choice, nil
+		}
+	}
+	return "", fmt.Errorf(">>Gost>> invalid %s %q, expected one of: %s", name, value, strings.Join(choices, ", "))
+}
+
+// ValidatePort checks that port is a usable TCP port.
+func ValidatePort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf(">>Gost>> invalid port %d, expected a number between 1 and 65535", port)
+	}
+	return nil
+}
+
+func normalizeChoice(s string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "", ".", "").Replace(strings.ToLower(strings.TrimSpace(s)))
+}
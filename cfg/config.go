@@ -6,10 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/user"
-	"path/filepath"
-	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/pelletier/go-toml"
 	"github.com/theHamdiz/gost/clr"
@@ -38,8 +36,13 @@ type GostConfig struct {
 	RedisPassword                string
 	RedisURI                     string
 	Server                       string
+	// Profiles are named sets of answers layered over the Preferred* defaults, see gost create --profile.
+	Profiles map[string]Answers `json:",omitempty" toml:",omitempty" yaml:",omitempty"`
 }
 
+// warnOnce keeps GetConfigPath from repeating the shadowed config warning.
+var warnOnce sync.Once
+
 type Configurable interface {
 	SaveAsEnv(filePath string) error
 	SaveAsJSON(filePath string) error
@@ -60,52 +63,49 @@ func (config *GostConfig) SaveAsEnv(filePath string) error {
 	}(file)
 
 	writer := bufio.NewWriter(file)
-	_, err = fmt.Fprintln(writer, ">>Gost>> PreferredIDE="+config.PreferredIDE)
-	if err != nil {
-		return err
-	}
-	_, err = fmt.Fprintln(writer, ">>Gost>> PreferredBackendFramework="+config.PreferredBackendFramework)
-	if err != nil {
-		return err
-	}
-	_, err = fmt.Fprintln(writer, ">>Gost>> PreferredUiFramework="+config.PreferredUiFramework)
-	if err != nil {
-		return err
-	}
-	_, err = fmt.Fprintln(writer, ">>Gost>> PreferredComponentsFramework="+config.PreferredComponentsFramework)
-	if err != nil {
-		return err
-	}
-	_, err = fmt.Fprintln(writer, ">>Gost>> PreferredDbDriver="+config.PreferredDbDriver)
-	if err != nil {
-		return err
-	}
-	_, err = fmt.Fprintln(writer, ">>Gost>> PreferredDbOrm="+config.PreferredDbOrm)
-	if err != nil {
-		return err
-	}
-	_, err = fmt.Fprintf(writer, ">>Gost>> PreferredPort=%d\n", config.PreferredPort)
-	if err != nil {
-		return err
+	for _, key := range envKeys() {
+		value, _ := config.Get(key)
+		if value == "" || value == "0" {
+			continue
+		}
+		if _, err := fmt.Fprintf(writer, "%s=%s\n", key, value); err != nil {
+			return err
+		}
 	}
-	_, err = fmt.Fprintln(writer, ">>Gost>> GlobalSettings="+config.GlobalSettings)
-	if err != nil {
-		return err
+	for _, name := range config.ProfileNames()[1:] {
+		for _, entry := range config.Profiles[name].Entries() {
+			if _, err := fmt.Fprintf(writer, "%s%s.%s=%s\n", envProfilePrefix, name, entry[0], entry[1]); err != nil {
+				return err
+			}
+		}
 	}
-	_, err = fmt.Fprintln(writer, ">>Gost>> PreferredConfigFormat="+config.PreferredConfigFormat)
-	if err != nil {
-		return err
+	return writer.Flush()
+}
+
+// envKeys orders the prompted preferences first, followed by the remaining fields in declaration order.
+func envKeys() []string {
+	keys := []string{
+		"PreferredIDE",
+		"PreferredBackendFramework",
+		"PreferredUiFramework",
+		"PreferredComponentsFramework",
+		"PreferredDbDriver",
+		"PreferredDbOrm",
+		"PreferredPort",
+		"GlobalSettings",
+		"PreferredConfigFormat",
+		"PreferredFrontEndFramework",
 	}
-	_, err = fmt.Fprintln(writer, ">>Gost>> PreferredFrontEndFramework="+config.PreferredFrontEndFramework)
-	if err != nil {
-		return err
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		seen[key] = true
 	}
-	err = writer.Flush()
-	if err != nil {
-		return err
+	for _, key := range Keys() {
+		if !seen[key] {
+			keys = append(keys, key)
+		}
 	}
-
-	return nil
+	return keys
 }
 
 func (config *GostConfig) SaveAsJSON(filePath string) error {
@@ -214,26 +214,12 @@ func LoadFromEnv(filePath string) (*GostConfig, error) {
 		if len(parts) != 2 {
 			continue
 		}
-		key, value := parts[0], parts[1]
-		switch key {
-		case "PreferredIDE":
-			config.PreferredIDE = value
-		case "PreferredBackendFramework":
-			config.PreferredBackendFramework = value
-		case "PreferredUiFramework":
-			config.PreferredUiFramework = value
-		case "PreferredComponentsFramework":
-			config.PreferredComponentsFramework = value
-		case "PreferredDbDriver":
-			config.PreferredDbDriver = value
-		case "PreferredDbOrm":
-			config.PreferredDbOrm = value
-		case "PreferredPort":
-			config.PreferredPort, _ = strconv.Atoi(value)
-		case "GlobalSettings":
-			config.GlobalSettings = value
-		case "PreferredConfigFormat":
-			config.PreferredConfigFormat = value
+		key := strings.TrimSpace(parts[0])
+		// Unknown keys are skipped so older gost versions can read newer files.
+		if strings.HasPrefix(key, envProfilePrefix) {
+			_ = config.setEnvProfileEntry(key, parts[1])
+		} else {
+			_ = config.setField(key, parts[1])
 		}
 	}
 	return config, scanner.Err()
@@ -292,25 +278,14 @@ func LoadFromYAML(filePath string) (*GostConfig, error) {
 	return config, decoder.Decode(config)
 }
 
+// GetConfigPath returns the global config file in use, see FindConfigs, or "" before the first run.
 func GetConfigPath() string {
-	usr, err := user.Current()
-	if err != nil {
-		fmt.Println(clr.Colorize(">>Gost>> Error getting user home directory", "red"))
+	found := FindConfigs()
+	if len(found) == 0 {
 		return ""
 	}
-
-	envFilePath := filepath.Join(usr.HomeDir, ".gost.env")
-	jsonFilePath := filepath.Join(usr.HomeDir, ".gost.json")
-	tomlFilePath := filepath.Join(usr.HomeDir, ".gost.toml")
-
-	if _, err := os.Stat(envFilePath); err == nil {
-		return envFilePath
-	} else if _, err := os.Stat(jsonFilePath); err == nil {
-		return jsonFilePath
-	} else if _, err := os.Stat(tomlFilePath); err == nil {
-		return tomlFilePath
-	}
-	return ""
+	warnOnce.Do(func() { warnShadowed(found) })
+	return found[0].Path
 }
 
 func ResetConfig(configPath string) {
@@ -336,27 +311,12 @@ func ShowConfig(configPath string) {
 		fmt.Println(clr.Colorize(">>Gost>> Could not find a config file. Please run gost init first.", "red"))
 		return
 	}
-	if strings.HasSuffix(configPath, ".toml") {
-		config, err := LoadFromTOML(configPath)
-		if err != nil {
-			fmt.Println(clr.Colorize(">>Gost>> Error loading config from .gost.toml file", "red"))
-			return
-		}
-		fmt.Println(clr.Colorize(fmt.Sprintf("%+v", config), "green"))
-	} else if strings.HasSuffix(configPath, ".json") {
-		config, err := LoadFromJSON(configPath)
-		if err != nil {
-			fmt.Println(clr.Colorize(">>Gost>> Error loading config from .gost.json file", "red"))
-			return
-		}
-		fmt.Println(clr.Colorize(fmt.Sprintf("%+v", config), "green"))
-	} else {
-		config, err := LoadFromEnv(configPath)
-		if err != nil {
-			fmt.Println(clr.Colorize(">>Gost>> Error loading config from .gost.env file", "red"))
-			return
-		}
-		fmt.Println(clr.Colorize(fmt.Sprintf("%+v", config), "green"))
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		fmt.Println(clr.Colorize(">>Gost>> Error loading config from "+configPath+": "+err.Error(), "red"))
+		return
+	}
+	if err := PrintConfig(os.Stdout, config, "table"); err != nil {
+		fmt.Println(clr.Colorize(err.Error(), "red"))
 	}
-
 }
@@ -0,0 +1,160 @@
+package cfg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/theHamdiz/gost/clr"
+)
+
+// configExts are the extensions a global config file may have, .yml is read as yaml.
+var configExts = []string{".env", ".json", ".toml", ".yaml", ".yml"}
+
+// ConfigFile is a global config file found on disk.
+type ConfigFile struct {
+	Path    string
+	ModTime int64
+}
+
+// FindConfigs lists the global config files by precedence, later ones are shadowed by the first:
+//
+//  1. the file $GOST_CONFIG points to,
+//  2. config.{env,json,toml,yaml,yml} in $XDG_CONFIG_HOME/gost (~/.config/gost when unset),
+//  3. ~/.gost.{env,json,toml,yaml,yml}.
+//
+// Within the same location the most recently modified file comes first.
+func FindConfigs() []ConfigFile {
+	var found []ConfigFile
+	if path := os.Getenv("GOST_CONFIG"); path != "" {
+		if info, err := os.Stat(path); err == nil {
+			found = append(found, ConfigFile{Path: path, ModTime: info.ModTime().UnixNano()})
+		}
+	}
+	if dir := xdgConfigDir(); dir != "" {
+		found = append(found, findIn(dir, "config")...)
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		found = append(found, findIn(home, ".gost")...)
+	}
+	return found
+}
+
+func findIn(dir, base string) []ConfigFile {
+	var found []ConfigFile
+	for _, ext := range configExts {
+		path := filepath.Join(dir, base+ext)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			found = append(found, ConfigFile{Path: path, ModTime: info.ModTime().UnixNano()})
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].ModTime > found[j].ModTime
+	})
+	return found
+}
+
+func xdgConfigDir() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "gost")
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, ".config", "gost")
+	}
+	return ""
+}
+
+// ConfigPathFor returns where a config in format is saved: $GOST_CONFIG when set, next to the
+// current config when there is one, $XDG_CONFIG_HOME/gost/config.<format> when XDG_CONFIG_HOME is set
+// and ~/.gost.<format> otherwise.
+func ConfigPathFor(format string) (string, error) {
+	format, err := MatchChoice("config format", format, ConfigFormats)
+	if err != nil {
+		return "", err
+	}
+	if path := os.Getenv("GOST_CONFIG"); path != "" {
+		return path, nil
+	}
+	if current := GetConfigPath(); current != "" {
+		return siblingPath(current, format), nil
+	}
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "gost", "config."+format), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".gost."+format), nil
+}
+
+// siblingPath swaps the extension of a config file, keeping its directory and base name.
+func siblingPath(path, format string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + "." + format
+}
+
+// SaveConfig writes config to the global config file of its PreferredConfigFormat and removes the
+// previous file when the format changed, so only one global config is left. It returns the new path.
+func SaveConfig(config *GostConfig) (string, error) {
+	path, err := ConfigPathFor(config.PreferredConfigFormat)
+	if err != nil {
+		return "", err
+	}
+	previous := GetConfigPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+	if err := config.Save(path); err != nil {
+		return "", err
+	}
+	if previous != "" && previous != path && filepath.Dir(previous) == filepath.Dir(path) {
+		if err := os.Remove(previous); err != nil {
+			return path, err
+		}
+	}
+	return path, nil
+}
+
+// Convert rewrites the current global config in another format and removes the old file.
+func Convert(to string) (from, path string, err error) {
+	to, err = MatchChoice("config format", to, ConfigFormats)
+	if err != nil {
+		return "", "", err
+	}
+	from = GetConfigPath()
+	if from == "" {
+		return "", "", errors.New(">>Gost>> Could not find a config file. Please run gost init first.")
+	}
+	if gostConfig := os.Getenv("GOST_CONFIG"); gostConfig != "" && gostConfig == from {
+		return from, "", fmt.Errorf(">>Gost>> GOST_CONFIG points to %s, point it to a .%s file and run gost config convert again", from, to)
+	}
+	path = siblingPath(from, to)
+	if path == from {
+		return from, path, fmt.Errorf(">>Gost>> %s is already in %s format", from, to)
+	}
+
+	config, err := LoadConfig(from)
+	if err != nil {
+		return from, "", err
+	}
+	config.PreferredConfigFormat = to
+	if err := config.Save(path); err != nil {
+		return from, "", err
+	}
+	return from, path, os.Remove(from)
+}
+
+// warnShadowed tells the user which config files are ignored in favor of the one in use.
+func warnShadowed(found []ConfigFile) {
+	if len(found) < 2 {
+		return
+	}
+	var ignored []string
+	for _, file := range found[1:] {
+		ignored = append(ignored, file.Path)
+	}
+	fmt.Println(clr.Colorize(fmt.Sprintf(">>Gost>> Using %s, ignoring %s", found[0].Path, strings.Join(ignored, ", ")), "teal"))
+}
@@ -0,0 +1,85 @@
+package cfg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// isolate points the config lookup at empty temporary directories.
+func isolate(t *testing.T) (home, xdg string) {
+	home, xdg = t.TempDir(), t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	t.Setenv("GOST_CONFIG", "")
+	return home, xdg
+}
+
+func TestGetConfigPathPrecedence(t *testing.T) {
+	home, xdg := isolate(t)
+	assert.Equal(t, "", GetConfigPath())
+
+	legacy := filepath.Join(home, ".gost.yaml")
+	createTestFile(t, legacy, "preferredide: Zed\n")
+	assert.Equal(t, legacy, GetConfigPath())
+
+	require.NoError(t, os.MkdirAll(filepath.Join(xdg, "gost"), 0755))
+	xdgConfig := filepath.Join(xdg, "gost", "config.toml")
+	createTestFile(t, xdgConfig, "PreferredIDE = \"Vim\"\n")
+	assert.Equal(t, xdgConfig, GetConfigPath())
+
+	explicit := filepath.Join(t.TempDir(), "team.json")
+	createTestFile(t, explicit, `{"PreferredIDE": "Emacs"}`)
+	t.Setenv("GOST_CONFIG", explicit)
+	assert.Equal(t, explicit, GetConfigPath())
+}
+
+func TestNewestConfigWinsWithinADirectory(t *testing.T) {
+	home, _ := isolate(t)
+	older, newer := filepath.Join(home, ".gost.env"), filepath.Join(home, ".gost.yaml")
+	createTestFile(t, older, "PreferredIDE=Zed\n")
+	createTestFile(t, newer, "preferredide: Vim\n")
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(older, past, past))
+
+	assert.Equal(t, newer, GetConfigPath())
+	config, err := LoadConfig(GetConfigPath())
+	assert.NoError(t, err)
+	assert.Equal(t, "Vim", config.PreferredIDE)
+}
+
+func TestSaveConfigAndConvert(t *testing.T) {
+	_, xdg := isolate(t)
+
+	path, err := SaveConfig(&GostConfig{PreferredIDE: "Zed", PreferredConfigFormat: "yaml"})
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(xdg, "gost", "config.yaml"), path)
+	assert.Equal(t, path, GetConfigPath())
+
+	from, to, err := Convert("TOML")
+	assert.NoError(t, err)
+	assert.Equal(t, path, from)
+	assert.Equal(t, filepath.Join(xdg, "gost", "config.toml"), to)
+	assert.NoFileExists(t, from)
+
+	config, err := LoadConfig(to)
+	assert.NoError(t, err)
+	assert.Equal(t, "Zed", config.PreferredIDE)
+	assert.Equal(t, "toml", config.PreferredConfigFormat)
+
+	_, _, err = Convert("toml")
+	assert.ErrorContains(t, err, "already in toml format")
+	_, _, err = Convert("xml")
+	assert.Error(t, err)
+
+	// Saving in another format replaces the old file instead of leaving two behind.
+	config.PreferredConfigFormat = "env"
+	path, err = SaveConfig(config)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(xdg, "gost", "config.env"), path)
+	assert.NoFileExists(t, to)
+}
@@ -0,0 +1,173 @@
+package cfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// fieldChoices restricts fields that are picked from a list when building the config.
+var fieldChoices = map[string][]string{
+	"PreferredIDE":                 IDEs,
+	"PreferredBackendFramework":    BackendFrameworks,
+	"PreferredDbDriver":            DbDrivers,
+	"PreferredDbOrm":               DbOrms,
+	"PreferredFrontEndFramework":   FrontEndFrameworks,
+	"PreferredUiFramework":         UiFrameworks,
+	"PreferredComponentsFramework": ComponentsFrameworks,
+	"GlobalSettings":               GlobalSettingsModes,
+	"PreferredConfigFormat":        ConfigFormats,
+}
+
+// secretFields are masked when the config is shown as a table.
+var secretFields = map[string]bool{
+	"AppKey":        true,
+	"EnvPassword":   true,
+	"RedisPassword": true,
+}
+
+// Keys returns the names of the plain GostConfig fields in declaration order, profiles are managed separately.
+func Keys() []string {
+	t := reflect.TypeOf(GostConfig{})
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		if kind := t.Field(i).Type.Kind(); kind == reflect.String || kind == reflect.Int {
+			keys = append(keys, t.Field(i).Name)
+		}
+	}
+	return keys
+}
+
+// field finds a field by name, ignoring case, dashes and underscores.
+func (config *GostConfig) field(key string) (reflect.Value, string, error) {
+	for _, name := range Keys() {
+		if normalizeChoice(name) == normalizeChoice(key) {
+			return reflect.ValueOf(config).Elem().FieldByName(name), name, nil
+		}
+	}
+	return reflect.Value{}, "", fmt.Errorf(">>Gost>> unknown config key %q, expected one of: %s", key, strings.Join(Keys(), ", "))
+}
+
+// Get returns the value of a field by name.
+func (config *GostConfig) Get(key string) (string, error) {
+	v, _, err := config.field(key)
+	if err != nil {
+		return "", err
+	}
+	if v.Kind() == reflect.Int {
+		return strconv.FormatInt(v.Int(), 10), nil
+	}
+	return v.String(), nil
+}
+
+// Set validates value against the allowed values of a field and stores it.
+func (config *GostConfig) Set(key, value string) error {
+	_, name, err := config.field(key)
+	if err != nil {
+		return err
+	}
+	if choices, ok := fieldChoices[name]; ok {
+		if value, err = MatchChoice(name, value, choices); err != nil {
+			return err
+		}
+	}
+	if name == "PreferredPort" {
+		port, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf(">>Gost>> invalid port %q, expected a number", value)
+		}
+		if err := ValidatePort(port); err != nil {
+			return err
+		}
+	}
+	return config.setField(name, value)
+}
+
+// Remove resets a field to its zero value, so it is asked for again on the next project creation.
+func (config *GostConfig) Remove(key string) error {
+	v, _, err := config.field(key)
+	if err != nil {
+		return err
+	}
+	v.Set(reflect.Zero(v.Type()))
+	return nil
+}
+
+// setField stores value without validation, used when loading saved files.
+func (config *GostConfig) setField(key, value string) error {
+	v, name, err := config.field(key)
+	if err != nil {
+		return err
+	}
+	if v.Kind() == reflect.Int {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf(">>Gost>> invalid %s %q, expected a number", name, value)
+		}
+		v.SetInt(int64(n))
+		return nil
+	}
+	v.SetString(value)
+	return nil
+}
+
+// LoadConfig loads a config file in the format given by its extension.
+func LoadConfig(filePath string) (*GostConfig, error) {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		return LoadFromJSON(filePath)
+	case ".toml":
+		return LoadFromTOML(filePath)
+	case ".yaml", ".yml":
+		return LoadFromYAML(filePath)
+	case ".env":
+		return LoadFromEnv(filePath)
+	default:
+		return nil, fmt.Errorf(">>Gost>> unsupported config file %s", filePath)
+	}
+}
+
+// Save writes the config in the format given by the file extension.
+func (config *GostConfig) Save(filePath string) error {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		return config.SaveAsJSON(filePath)
+	case ".toml":
+		return config.SaveAsTOML(filePath)
+	case ".yaml", ".yml":
+		return config.SaveAsYAML(filePath)
+	case ".env":
+		return config.SaveAsEnv(filePath)
+	default:
+		return fmt.Errorf(">>Gost>> unsupported config file %s", filePath)
+	}
+}
+
+// PrintConfig renders config as an aligned key/value table or, with output json, as indented JSON.
+func PrintConfig(w io.Writer, config *GostConfig, output string) error {
+	switch strings.ToLower(output) {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(config)
+	case "", "table":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, key := range Keys() {
+			value, _ := config.Get(key)
+			if value != "" && secretFields[key] {
+				value = "********"
+			}
+			if _, err := fmt.Fprintf(tw, "%s\t%s\n", key, value); err != nil {
+				return err
+			}
+		}
+		return tw.Flush()
+	default:
+		return fmt.Errorf(">>Gost>> unsupported output %q, expected table or json", output)
+	}
+}
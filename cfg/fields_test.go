@@ -0,0 +1,64 @@
+package cfg
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSetRemove(t *testing.T) {
+	config := &GostConfig{}
+
+	assert.NoError(t, config.Set("preferredbackendframework", "chi"))
+	assert.Equal(t, "Chi", config.PreferredBackendFramework)
+	assert.NoError(t, config.Set("preferred_port", "8081"))
+	assert.Equal(t, 8081, config.PreferredPort)
+	assert.NoError(t, config.Set("RedisURI", "redis://localhost:6379"))
+
+	value, err := config.Get("PREFERREDPORT")
+	assert.NoError(t, err)
+	assert.Equal(t, "8081", value)
+
+	assert.ErrorContains(t, config.Set("PreferredBackendFramework", "rails"), "expected one of: Gin, Chi, Echo, Fiber, StdLib")
+	assert.ErrorContains(t, config.Set("PreferredPort", "0"), "between 1 and 65535")
+	assert.ErrorContains(t, config.Set("PreferredPort", "eighty"), "expected a number")
+	assert.ErrorContains(t, config.Set("Nope", "x"), "unknown config key")
+
+	assert.NoError(t, config.Remove("PreferredPort"))
+	assert.Zero(t, config.PreferredPort)
+}
+
+func TestSaveAndLoadConfigRoundTrip(t *testing.T) {
+	config := &GostConfig{
+		PreferredIDE:               "Zed",
+		PreferredBackendFramework:  "Chi",
+		PreferredFrontEndFramework: "Htmx",
+		PreferredPort:              8081,
+		GlobalSettings:             "No set it & forget it.",
+		RedisURI:                   "redis://localhost:6379",
+	}
+	for _, ext := range []string{".env", ".json", ".toml", ".yaml"} {
+		filePath := filepath.Join(t.TempDir(), ".gost"+ext)
+		assert.NoError(t, config.Save(filePath), ext)
+		loaded, err := LoadConfig(filePath)
+		assert.NoError(t, err, ext)
+		assert.Equal(t, config, loaded, ext)
+	}
+}
+
+func TestPrintConfig(t *testing.T) {
+	config := &GostConfig{PreferredIDE: "Zed", RedisPassword: "hunter2"}
+
+	var table bytes.Buffer
+	assert.NoError(t, PrintConfig(&table, config, "table"))
+	assert.Contains(t, table.String(), "PreferredIDE                  Zed\n")
+	assert.NotContains(t, table.String(), "hunter2")
+
+	var out bytes.Buffer
+	assert.NoError(t, PrintConfig(&out, config, "json"))
+	assert.Contains(t, out.String(), `"PreferredIDE": "Zed"`)
+
+	assert.Error(t, PrintConfig(&out, config, "xml"))
+}
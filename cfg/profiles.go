@@ -0,0 +1,143 @@
+package cfg
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultProfile is the name of the Preferred* values every other profile inherits from.
+const DefaultProfile = "default"
+
+// envProfilePrefix prefixes profile entries in .env configs, e.g. Profiles.api.backend=Gin.
+const envProfilePrefix = "Profiles."
+
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ProfileNames returns the default profile followed by the named profiles in alphabetical order.
+func (config *GostConfig) ProfileNames() []string {
+	names := make([]string, 0, len(config.Profiles))
+	for name := range config.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{DefaultProfile}, names...)
+}
+
+// Profile returns the answers of a named profile, the default profile has none of its own.
+func (config *GostConfig) Profile(name string) (Answers, error) {
+	if name == "" || name == DefaultProfile {
+		return Answers{}, nil
+	}
+	profile, ok := config.Profiles[name]
+	if !ok {
+		return Answers{}, fmt.Errorf(">>Gost>> unknown profile %q, available profiles: %s", name, strings.Join(config.ProfileNames(), ", "))
+	}
+	return profile, nil
+}
+
+// SetProfile validates answers and merges them into the named profile, creating it when needed.
+func (config *GostConfig) SetProfile(name string, answers Answers) error {
+	if name == DefaultProfile {
+		return fmt.Errorf(">>Gost>> the %s profile is the top level config, change it with gost config set", DefaultProfile)
+	}
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf(">>Gost>> invalid profile name %q, use letters, digits, dashes and underscores", name)
+	}
+	if err := answers.Validate(); err != nil {
+		return err
+	}
+	if config.Profiles == nil {
+		config.Profiles = make(map[string]Answers)
+	}
+	config.Profiles[name] = config.Profiles[name].Merge(answers)
+	return nil
+}
+
+// RemoveProfile deletes a named profile.
+func (config *GostConfig) RemoveProfile(name string) error {
+	if _, ok := config.Profiles[name]; !ok {
+		return fmt.Errorf(">>Gost>> unknown profile %q", name)
+	}
+	delete(config.Profiles, name)
+	if len(config.Profiles) == 0 {
+		config.Profiles = nil
+	}
+	return nil
+}
+
+// WithProfile returns a copy of config with the named profile applied over the default values.
+func (config *GostConfig) WithProfile(name string) (*GostConfig, error) {
+	profile, err := config.Profile(name)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *config
+	profile.Apply(&resolved)
+	return &resolved, nil
+}
+
+// Entries lists the non empty answers as key/value pairs, keys are the answers file keys.
+func (answers Answers) Entries() [][2]string {
+	var entries [][2]string
+	v, t := reflect.ValueOf(answers), reflect.TypeOf(answers)
+	for i := 0; i < t.NumField(); i++ {
+		var value string
+		if v.Field(i).Kind() == reflect.Int {
+			if v.Field(i).Int() != 0 {
+				value = strconv.FormatInt(v.Field(i).Int(), 10)
+			}
+		} else {
+			value = v.Field(i).String()
+		}
+		if value != "" {
+			entries = append(entries, [2]string{answerKey(t.Field(i)), value})
+		}
+	}
+	return entries
+}
+
+// set stores a single answer by its answers file key.
+func (answers *Answers) set(key, value string) error {
+	v, t := reflect.ValueOf(answers).Elem(), reflect.TypeOf(*answers)
+	for i := 0; i < t.NumField(); i++ {
+		if answerKey(t.Field(i)) != key {
+			continue
+		}
+		if v.Field(i).Kind() == reflect.Int {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf(">>Gost>> invalid %s %q, expected a number", key, value)
+			}
+			v.Field(i).SetInt(int64(n))
+			return nil
+		}
+		v.Field(i).SetString(value)
+		return nil
+	}
+	return fmt.Errorf(">>Gost>> unknown profile key %q", key)
+}
+
+func answerKey(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("yaml"), ",")[0]
+}
+
+// setEnvProfileEntry parses a Profiles.<name>.<key>=value line of a .env config.
+func (config *GostConfig) setEnvProfileEntry(key, value string) error {
+	name, answerKey, ok := strings.Cut(strings.TrimPrefix(key, envProfilePrefix), ".")
+	if !ok {
+		return fmt.Errorf(">>Gost>> invalid profile entry %q", key)
+	}
+	profile := config.Profiles[name]
+	if err := profile.set(answerKey, value); err != nil {
+		return err
+	}
+	if config.Profiles == nil {
+		config.Profiles = make(map[string]Answers)
+	}
+	config.Profiles[name] = profile
+	return nil
+}
@@ -0,0 +1,53 @@
+package cfg
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfilesInheritFromDefault(t *testing.T) {
+	config := &GostConfig{PreferredIDE: "Zed", PreferredBackendFramework: "Chi", PreferredDbDriver: "Sqlite", PreferredPort: 9630}
+
+	assert.NoError(t, config.SetProfile("api", Answers{Backend: "gin", Db: "postgresql"}))
+	assert.NoError(t, config.SetProfile("api", Answers{Port: 8080}))
+	assert.Equal(t, Answers{Backend: "Gin", Db: "Postgresql", Port: 8080}, config.Profiles["api"])
+	assert.Equal(t, []string{"default", "api"}, config.ProfileNames())
+
+	resolved, err := config.WithProfile("api")
+	assert.NoError(t, err)
+	assert.Equal(t, "Gin", resolved.PreferredBackendFramework)
+	assert.Equal(t, "Postgresql", resolved.PreferredDbDriver)
+	assert.Equal(t, 8080, resolved.PreferredPort)
+	assert.Equal(t, "Zed", resolved.PreferredIDE)
+	assert.Equal(t, "Chi", config.PreferredBackendFramework)
+
+	defaults, err := config.WithProfile(DefaultProfile)
+	assert.NoError(t, err)
+	assert.Equal(t, "Chi", defaults.PreferredBackendFramework)
+
+	assert.ErrorContains(t, config.SetProfile("default", Answers{}), "top level config")
+	assert.ErrorContains(t, config.SetProfile("my api", Answers{}), "invalid profile name")
+	assert.Error(t, config.SetProfile("tools", Answers{Backend: "rails"}))
+	_, err = config.Profile("tools")
+	assert.ErrorContains(t, err, "available profiles: default, api")
+
+	assert.NoError(t, config.RemoveProfile("api"))
+	assert.Nil(t, config.Profiles)
+	assert.Error(t, config.RemoveProfile("api"))
+}
+
+func TestProfilesRoundTrip(t *testing.T) {
+	config := &GostConfig{PreferredIDE: "Zed", PreferredPort: 9630}
+	assert.NoError(t, config.SetProfile("api", Answers{Backend: "Gin", Db: "Postgresql", Port: 8080}))
+	assert.NoError(t, config.SetProfile("tools", Answers{Backend: "Chi", Db: "Sqlite"}))
+
+	for _, ext := range []string{".env", ".json", ".toml", ".yaml"} {
+		filePath := filepath.Join(t.TempDir(), ".gost"+ext)
+		assert.NoError(t, config.Save(filePath), ext)
+		loaded, err := LoadConfig(filePath)
+		assert.NoError(t, err, ext)
+		assert.Equal(t, config, loaded, ext)
+	}
+}
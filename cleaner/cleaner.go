@@ -1,61 +1,251 @@
 package cleaner
 
 import (
-	"fmt"
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 )
 
-func SortImports(filePath string) error {
-	// Read the file content
+// knownPackages are added as imports when a file uses them without importing them.
+// Names shared by several packages, like template or rand, are left out on purpose.
+var knownPackages = map[string]string{
+	"bufio":    "bufio",
+	"bytes":    "bytes",
+	"context":  "context",
+	"errors":   "errors",
+	"exec":     "os/exec",
+	"filepath": "path/filepath",
+	"fmt":      "fmt",
+	"fs":       "io/fs",
+	"http":     "net/http",
+	"io":       "io",
+	"json":     "encoding/json",
+	"log":      "log",
+	"math":     "math",
+	"net":      "net",
+	"os":       "os",
+	"reflect":  "reflect",
+	"regexp":   "regexp",
+	"signal":   "os/signal",
+	"slog":     "log/slog",
+	"sort":     "sort",
+	"sql":      "database/sql",
+	"strconv":  "strconv",
+	"strings":  "strings",
+	"sync":     "sync",
+	"syscall":  "syscall",
+	"time":     "time",
+	"unicode":  "unicode",
+	"url":      "net/url",
+}
+
+// OrganizeFile organizes the imports of the Go file at filePath in place, see OrganizeImports.
+func OrganizeFile(filePath, module string) error {
 	content, err := os.ReadFile(filePath)
 	if err != nil {
 		return err
 	}
+	organized, err := OrganizeImports(content, module)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, organized, 0644)
+}
+
+// OrganizeImports rewrites the imports of a Go source file into one block with the standard library,
+// third-party and module local packages in sorted groups, drops unused imports, adds missing ones
+// from knownPackages and formats the result. Comments on imports and everything outside the
+// import declarations, like build tags, are kept as they are.
+func OrganizeImports(src []byte, module string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
 
-	// Convert content to string
-	contentStr := string(content)
+	var decls []*ast.GenDecl
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT && !importsC(gen) {
+			decls = append(decls, gen)
+		}
+	}
+	used := usedPackages(file)
 
-	// Find the import section
-	startIdx := strings.Index(contentStr, "import (")
-	if startIdx == -1 {
-		// No Import Section Found, Just return nil.
-		return nil
+	var imports []importLine
+	attached := make(map[*ast.CommentGroup]bool)
+	imported := make(map[string]bool)
+	for _, decl := range decls {
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.ImportSpec)
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return nil, err
+			}
+			attached[spec.Doc], attached[spec.Comment] = true, true
+			name, known := importName(spec, path)
+			if known && name != "_" && name != "." && !used[name] {
+				continue
+			}
+			imported[name] = true
+			line := importLine{path: path, text: string(src[offset(fset, spec.Pos()):offset(fset, spec.End())])}
+			if spec.Doc != nil {
+				line.doc = string(src[offset(fset, spec.Doc.Pos()):offset(fset, spec.Doc.End())])
+			}
+			if spec.Comment != nil {
+				line.comment = string(src[offset(fset, spec.Comment.Pos()):offset(fset, spec.Comment.End())])
+			}
+			imports = append(imports, line)
+		}
 	}
-	endIdx := strings.Index(contentStr[startIdx:], ")")
-	if endIdx == -1 {
-		return fmt.Errorf(">>Gost>>no closing parenthesis for import section found")
+	for name := range used {
+		if path, ok := knownPackages[name]; ok && !imported[name] {
+			imports = append(imports, importLine{path: path, text: strconv.Quote(path)})
+		}
 	}
-	endIdx += startIdx
 
-	// Extract the import section
-	importSection := contentStr[startIdx+len("import (") : endIdx]
-	importLines := strings.Split(importSection, "\n")
+	if len(decls) == 0 && len(imports) == 0 {
+		return format.Source(src)
+	}
 
-	// Trim spaces and filter out empty lines
-	var imports []string
-	for _, line := range importLines {
-		line = strings.TrimSpace(line)
-		if line != "" {
-			imports = append(imports, line)
+	var out bytes.Buffer
+	start, end := offset(fset, file.Name.End()), offset(fset, file.Name.End())
+	if len(decls) > 0 {
+		start = offset(fset, decls[0].Pos())
+		end = offset(fset, decls[len(decls)-1].End())
+		// Comments between the import declarations that belong to no import stay above the block.
+		for _, group := range file.Comments {
+			if offset(fset, group.Pos()) > start && offset(fset, group.End()) < end && !attached[group] {
+				out.Write(src[offset(fset, group.Pos()):offset(fset, group.End())])
+				out.WriteString("\n")
+			}
 		}
+	} else {
+		out.WriteString("\n\n")
 	}
+	writeImports(&out, imports, module)
 
-	// Sort the imports
-	sort.Strings(imports)
+	result := append(append(append([]byte{}, src[:start]...), out.Bytes()...), src[end:]...)
+	return format.Source(result)
+}
 
-	// Reconstruct the import section
-	sortedImports := "import (\n    " + strings.Join(imports, "\n    ") + "\n)"
+type importLine struct {
+	path    string
+	text    string
+	doc     string
+	comment string
+}
 
-	// Replace the original import section with the sorted one
-	newContent := contentStr[:startIdx] + sortedImports + contentStr[endIdx+1:]
+func writeImports(out *bytes.Buffer, imports []importLine, module string) {
+	groups := make([][]importLine, 3)
+	for _, line := range imports {
+		group := 1
+		switch {
+		case module != "" && (line.path == module || strings.HasPrefix(line.path, module+"/")):
+			group = 2
+		case !strings.Contains(strings.Split(line.path, "/")[0], "."):
+			group = 0
+		}
+		groups[group] = append(groups[group], line)
+	}
 
-	// Write the sorted content back to the file
-	err = os.WriteFile(filePath, []byte(newContent), 0644)
-	if err != nil {
-		return err
+	if len(imports) == 1 && imports[0].doc == "" {
+		out.WriteString("import " + imports[0].text + imports[0].suffix())
+		return
+	}
+	if len(imports) == 0 {
+		return
+	}
+	out.WriteString("import (\n")
+	first := true
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		if !first {
+			out.WriteString("\n")
+		}
+		first = false
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].path < group[j].path
+		})
+		for _, line := range group {
+			if line.doc != "" {
+				out.WriteString("\t" + line.doc + "\n")
+			}
+			out.WriteString("\t" + line.text + line.suffix() + "\n")
+		}
+	}
+	out.WriteString(")")
+}
+
+func (l importLine) suffix() string {
+	if l.comment == "" {
+		return ""
+	}
+	return " " + l.comment
+}
+
+// usedPackages returns the names used as the left side of a selector that do not refer to a declaration in the file.
+func usedPackages(file *ast.File) map[string]bool {
+	used := make(map[string]bool)
+	ast.Inspect(file, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Obj == nil {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	return used
+}
+
+// importName returns the name an import is used by, known reports whether an assumed name is safe
+// enough to remove the import when unused.
+func importName(spec *ast.ImportSpec, path string) (name string, known bool) {
+	if spec.Name != nil {
+		return spec.Name.Name, true
 	}
+	name = AssumedName(path)
+	return name, token.IsIdentifier(name)
+}
+
+// AssumedName returns the package name of an import path the way goimports assumes it without loading
+// the package: the last element without a major version or a go- prefix, github.com/go-chi/chi/v5 is chi.
+func AssumedName(path string) string {
+	elems := strings.Split(path, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && isMajorVersion(name) {
+		name = elems[len(elems)-2]
+	}
+	if i := strings.Index(name, ".v"); i > 0 && isMajorVersion(name[i+1:]) {
+		name = name[:i]
+	}
+	return strings.TrimPrefix(name, "go-")
+}
+
+func isMajorVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	_, err := strconv.Atoi(s[1:])
+	return err == nil
+}
+
+func importsC(decl *ast.GenDecl) bool {
+	for _, spec := range decl.Specs {
+		if spec.(*ast.ImportSpec).Path.Value == `"C"` {
+			return true
+		}
+	}
+	return false
+}
 
-	return nil
+func offset(fset *token.FileSet, pos token.Pos) int {
+	return fset.Position(pos).Offset
 }
@@ -0,0 +1,85 @@
+package cleaner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizeImports(t *testing.T) {
+	src := `//go:build linux
+
+// Package server runs the app.
+package server
+
+import (
+    "github.com/go-chi/chi/v5"
+    "blog/app/router" // routes (v1)
+    "os"
+
+    // drivers register themselves
+    _ "github.com/lib/pq"
+    "strings"
+    yaml "gopkg.in/yaml.v3"
+)
+
+func Run() {
+	r := chi.NewRouter()
+	router.Mount(r)
+	fmt.Println(os.Args, time.Now())
+	_ = yaml.Marshal
+}
+`
+	expected := `//go:build linux
+
+// Package server runs the app.
+package server
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	// drivers register themselves
+	_ "github.com/lib/pq"
+	yaml "gopkg.in/yaml.v3"
+
+	"blog/app/router" // routes (v1)
+)
+
+func Run() {
+	r := chi.NewRouter()
+	router.Mount(r)
+	fmt.Println(os.Args, time.Now())
+	_ = yaml.Marshal
+}
+`
+	organized, err := OrganizeImports([]byte(src), "blog")
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(organized))
+}
+
+func TestOrganizeImportsAddsAndRemovesDeclarations(t *testing.T) {
+	organized, err := OrganizeImports([]byte("package main\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n"), "blog")
+	require.NoError(t, err)
+	assert.Equal(t, "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n", string(organized))
+
+	organized, err = OrganizeImports([]byte("package main\n\nimport \"fmt\"\nimport \"os\"\n\nfunc main() {}\n"), "blog")
+	require.NoError(t, err)
+	assert.Equal(t, "package main\n\nfunc main() {}\n", string(organized))
+}
+
+func TestOrganizeImportsKeepsLocalNames(t *testing.T) {
+	// A parameter named like a package is not a use of the package.
+	src := "package handlers\n\nimport \"net/http\"\n\nfunc Handle(http string) string {\n\treturn http\n}\n\nfunc Log(log *Logger) {\n\tlog.Print()\n}\n"
+	organized, err := OrganizeImports([]byte(src), "blog")
+	require.NoError(t, err)
+	assert.NotContains(t, string(organized), "import")
+}
+
+func TestOrganizeImportsSyntaxError(t *testing.T) {
+	_, err := OrganizeImports([]byte("package main\n\nfunc main() {\n"), "blog")
+	assert.Error(t, err)
+}
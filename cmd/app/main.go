@@ -15,7 +15,10 @@ func main() {
 		Config: helpers.Config,
 	}
 	if len(os.Args) == 1 {
-		helpers.BuildConfig(c.Config)
+		if err := helpers.BuildConfig(c.Config, cfg.Answers{}, false); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 	} else {
 		rootCmd := helpers.AddCommands(*c.Config)
 		c.Commands = rootCmd
@@ -40,6 +40,11 @@ import (
 	{{if eq .BackendPkg "gin"}}
 	"github.com/gin-gonic/gin"
 	{{end}}
+	{{if eq .BackendPkg "fiber"}}
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/gofiber/fiber/v2/middleware/recover"
+	{{end}}
 )
 
 // StartHTTPServer starts the HTTP server based on the BackendPkg variable.
@@ -55,6 +60,9 @@ func StartHTTPServer() {
 	{{end}}
 	{{if eq .BackendPkg "gin"}}
 	log.Println("Starting HTTP server using gin")
+	{{end}}
+	{{if eq .BackendPkg "fiber"}}
+	log.Println("Starting HTTP server using fiber")
 	{{end}}	
 	startServer()
 }
@@ -65,8 +73,8 @@ func startServer() {
 
 	{{if eq .BackendPkg "stdlib"}}
 	mux := http.NewServeMux()
-	mux.HandleFunc("/hello", helloHandler)
-	mux.HandleFunc("/", rootHandler)
+	mux.HandleFunc("GET /hello", helloHandler)
+	mux.HandleFunc("GET /{$}", rootHandler)
 
 	server := &http.Server{
 		Addr:    ":{{.Port}}",
@@ -114,12 +122,28 @@ func startServer() {
 	}
 	{{end}}
 
+	{{if eq .BackendPkg "fiber"}}
+	// fiber serves with fasthttp instead of net/http, it listens and shuts down on its own.
+	app := fiber.New()
+	app.Use(logger.New())
+	app.Use(recover.New())
+	app.Get("/hello", helloHandler)
+	app.Get("/", rootHandler)
+
+	go func() {
+		if err := app.Listen(":{{.Port}}"); err != nil {
+			log.Fatalf("Listen(): %v", err)
+		}
+	}()
+	gracefulShutdown(app)
+	{{- else}}
 	go func() {
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Fatalf("ListenAndServe(): %v", err)
 		}
 	}()
 	gracefulShutdown(server)
+	{{- end}}
 }
 
 {{if eq .BackendPkg "stdlib"}}
@@ -169,6 +193,17 @@ func rootHandler(c *gin.Context) {
 }
 {{end}}
 
+{{if eq .BackendPkg "fiber"}}
+// helloHandler handles the /hello endpoint for your fiber server.
+func helloHandler(c *fiber.Ctx) error {
+	return c.SendString("Hello, World!")
+}
+// rootHandler handles the / endpoint for your fiber server.
+func rootHandler(c *fiber.Ctx) error {
+	return c.SendString("Welcome to your gost app!")
+}
+{{end}}
+
 
 // OnServerShutdown registers middleware functions to be called upon server shutdown.
 func OnServerShutdown(middleware ...func()) {
@@ -500,7 +535,11 @@ func (g *GenApiPlugin) GitHub() string {
 }
 
 func (g *GenApiPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenApiPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenApiPlugin(data config.ProjectData) *GenApiPlugin {
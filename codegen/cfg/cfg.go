@@ -1,9 +1,6 @@
 package cfg
 
 import (
-	"bytes"
-	"html/template"
-
 	"github.com/theHamdiz/gost/codegen/general"
 	"github.com/theHamdiz/gost/config"
 )
@@ -18,28 +15,29 @@ const cfgTemplate = `package cfg
 import (
     "os"
     "strings"
+    {{- if eq .PreferredConfigFormat "json"}}
+    "encoding/json"
+    {{- else if eq .PreferredConfigFormat "toml"}}
+
+    "github.com/BurntSushi/toml"
+    {{- else if eq .PreferredConfigFormat "yaml"}}
+
+    "gopkg.in/yaml.v3"
+    {{- end }}
 )
 
 var(
-	{{- if eq .PreferredConfigFormat ".env"}}
-	ConfigFile string = ".gost.env"
-	{{- else if eq .PreferredConfigFormat ".json"}}
-	ConfigFile string = ".gost.json"
-	{{- else if eq .PreferredConfigFormat ".toml"}}
-	ConfigFile string = ".gost.toml"
-	{{- else if eq .PreferredConfigFormat ".yaml"}}
-	ConfigFile string = ".gost.yaml"
-	{{- end }}
+	ConfigFile string = "{{.ConfigFile}}"
 )
 
 type Configurable interface {
-	{{- if eq .PreferredConfigFormat ".env"}}
+	{{- if eq .PreferredConfigFormat "env"}}
 	SaveAsEnv(filePath string) error
-	{{- else if eq .PreferredConfigFormat ".json"}}
+	{{- else if eq .PreferredConfigFormat "json"}}
 	SaveAsJSON(filePath string) error
-	{{- else if eq .PreferredConfigFormat ".toml"}}
+	{{- else if eq .PreferredConfigFormat "toml"}}
 	SaveAsTOML(filePath string) error
-	{{- else if eq .PreferredConfigFormat ".yaml"}}
+	{{- else if eq .PreferredConfigFormat "yaml"}}
 	SaveAsYAML(filePath string) error
 	{{- end }}
 }
@@ -85,7 +83,7 @@ func getEnvBool(key string, defaultValue bool) bool {
 }
 
 func LoadConfig() (*Config, error) {
-	{{- if eq .ConfigFile ".env"}}
+	{{- if eq .PreferredConfigFormat "env"}}
     return &Config{
         GostEnv:                      getEnv("GOST_ENV", "DEV"),
         Port:                         getEnv("PORT", ":9630"),
@@ -103,7 +101,7 @@ func LoadConfig() (*Config, error) {
         BackendPkg:                   getEnv("GOST_BACKEND", "gin"),
     }, nil
 
-	{{- else if eq .PreferredConfigFormat ".json"}}
+	{{- else if eq .PreferredConfigFormat "json"}}
 		file, err := os.Open(ConfigFile)
 		if err != nil {
 			return nil, err
@@ -115,7 +113,7 @@ func LoadConfig() (*Config, error) {
 		err = decoder.Decode(config)
 		return config, err
 
-	{{- else if eq .PreferredConfigFormat ".toml"}}
+	{{- else if eq .PreferredConfigFormat "toml"}}
 		file, err := os.Open(ConfigFile)
 		if err != nil {
 			return nil, err
@@ -127,7 +125,7 @@ func LoadConfig() (*Config, error) {
 		err = decoder.Decode(config)
 		return config, err
 
-	{{- else if eq .PreferredConfigFormat ".yaml"}}
+	{{- else if eq .PreferredConfigFormat "yaml"}}
 		file, err := os.Open(ConfigFile)
 		if err != nil {
 			return nil, err
@@ -145,24 +143,14 @@ func LoadConfig() (*Config, error) {
 func (g *GenConfPlugin) Init() error {
 	g.Files = map[string]func() string{
 		"app/cfg/cfg.go": func() string {
-			tmpl, err := template.New("cfg").Parse(cfgTemplate)
-			if err != nil {
-				panic(err)
-			}
-
-			var buf bytes.Buffer
-			if err := tmpl.Execute(&buf, g.Data); err != nil {
-				panic(err)
-			}
-
-			return buf.String()
+			return cfgTemplate
 		},
 	}
 	return nil
 }
 
 func (g *GenConfPlugin) Execute() error {
-	return general.GenerateFiles(g.Data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), g.Data, g.Files)
 }
 
 func (g *GenConfPlugin) Shutdown() error {
@@ -170,7 +158,7 @@ func (g *GenConfPlugin) Shutdown() error {
 }
 
 func (g *GenConfPlugin) Name() string {
-	return "Configuration Code GenConfPlugin"
+	return "GenConfPlugin"
 }
 
 func (g *GenConfPlugin) Version() string {
@@ -198,7 +186,11 @@ func (g *GenConfPlugin) GitHub() string {
 }
 
 func (g *GenConfPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenConfPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenConfPlugin(data config.ProjectData) *GenConfPlugin {
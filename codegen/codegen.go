@@ -2,12 +2,20 @@ package codegen
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/theHamdiz/gost/codegen/api"
 	"github.com/theHamdiz/gost/codegen/cfg"
 	"github.com/theHamdiz/gost/codegen/db"
 	"github.com/theHamdiz/gost/codegen/events"
 	"github.com/theHamdiz/gost/codegen/files"
+	"github.com/theHamdiz/gost/codegen/fingerprint"
+	"github.com/theHamdiz/gost/codegen/general"
 	"github.com/theHamdiz/gost/codegen/handlers"
 	"github.com/theHamdiz/gost/codegen/middleware"
 	genPlugins "github.com/theHamdiz/gost/codegen/plugins"
@@ -15,14 +23,130 @@ import (
 	"github.com/theHamdiz/gost/codegen/scripts"
 	"github.com/theHamdiz/gost/codegen/services"
 	"github.com/theHamdiz/gost/codegen/types"
+	"github.com/theHamdiz/gost/codegen/verify"
 	"github.com/theHamdiz/gost/codegen/web"
 	"github.com/theHamdiz/gost/config"
 	"github.com/theHamdiz/gost/plugins"
 	pmCfg "github.com/theHamdiz/gost/plugins/config"
+	"github.com/theHamdiz/gost/plugins/loader"
+	"github.com/theHamdiz/gost/plugins/store"
 )
 
-func ExecuteGeneration(data config.ProjectData) error {
-	generators := []plugins.Plugin{
+// LoadSettings loads the plugin settings file of the project, or of the directory gost runs in while
+// the project is created, and adds the features it leaves out to the ones in data.Without.
+func LoadSettings(data *config.ProjectData) (*pmCfg.PluginConfig, error) {
+	settings, err := pmCfg.LoadProjectConfig(data.ProjectDir, ".")
+	if err != nil {
+		return nil, err
+	}
+	without := append(append([]string{}, data.Without...), settings.Without...)
+	if data.Without, err = general.ParseFeatures(without); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// Options control how generated files reach the project.
+type Options struct {
+	// DryRun renders every template but only lists the files that would be created or modified.
+	DryRun bool
+	// Diff implies DryRun and also prints a unified diff of every file that would change.
+	Diff bool
+	// Strict type-checks the generated Go code and fails when it has problems, syntax errors are only warned
+	// about otherwise.
+	Strict bool
+	// Reproducible makes two runs with the same inputs write byte-identical files, see Reproducible.
+	Reproducible bool
+	// OnConflict decides what happens to project files changed since they were generated,
+	// they are kept when nil.
+	OnConflict general.ConflictResolver
+	// Output receives the dry run listing and the resolved conflicts, os.Stdout when nil.
+	Output io.Writer
+}
+
+// Run stages the files written by generate and commits them to the project once it succeeded,
+// or only reports them for a dry run. A failing generate leaves the project untouched.
+func Run(data config.ProjectData, opts Options, generate func(data config.ProjectData) error) error {
+	if opts.Reproducible {
+		Reproducible(&data)
+	}
+	target := general.ProjectRoot(data)
+	staged, err := general.NewStagingDir(data)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(staged.OutputDir)
+
+	if err := generate(staged); err != nil {
+		return fmt.Errorf(">>Gost>> code generation failed, nothing was written to %s:\n%w", target, err)
+	}
+	out := opts.Output
+	if out == nil {
+		out = os.Stdout
+	}
+	if err := verifyStaging(staged, target, opts.Strict); err != nil {
+		if opts.Strict {
+			return fmt.Errorf(">>Gost>> the generated code does not compile, nothing was written to %s:\n%w", target, err)
+		}
+		fmt.Fprintf(out, "Warning: the generated code has syntax errors, --strict stops on them:\n%v\n", err)
+	}
+	if !opts.DryRun && !opts.Diff {
+		resolve := opts.OnConflict
+		if resolve == nil {
+			resolve = general.Resolve(general.ConflictSkip)
+		}
+		conflicts, err := general.CommitStaging(staged, resolve)
+		printConflicts(out, conflicts)
+		return err
+	}
+
+	changes, err := general.PlanStaging(staged)
+	if err != nil {
+		return err
+	}
+	if opts.Diff {
+		if err := general.PrintDiff(out, staged, changes); err != nil {
+			return err
+		}
+	}
+	return general.PrintPlan(out, target, changes)
+}
+
+// ReproducibleEpoch is the time of a reproducible run, unless SOURCE_DATE_EPOCH sets it in seconds.
+var ReproducibleEpoch = time.Unix(0, 0).UTC()
+
+// Reproducible fixes the clock of data to SOURCE_DATE_EPOCH or ReproducibleEpoch and derives its
+// random bytes from the app name, so generating twice yields the same files.
+func Reproducible(data *config.ProjectData) {
+	epoch := ReproducibleEpoch
+	if seconds, err := strconv.ParseInt(os.Getenv("SOURCE_DATE_EPOCH"), 10, 64); err == nil {
+		epoch = time.Unix(seconds, 0).UTC()
+	}
+	data.Clock = func() time.Time { return epoch }
+	data.Entropy = fingerprint.Seeded("gost:" + data.AppName)
+	data.CurrentYear = epoch.Year()
+}
+
+func ExecuteGeneration(data config.ProjectData, opts Options) error {
+	settings, err := LoadSettings(&data)
+	if err != nil {
+		return err
+	}
+	return Run(data, opts, func(data config.ProjectData) error {
+		return generate(data, settings)
+	})
+}
+
+// TemplatePlugin is a built-in generator whose templates can be overridden, see general.TemplatesDir.
+type TemplatePlugin interface {
+	plugins.Plugin
+	// Templates returns the templates of the files the plugin generates, keyed by path, once Init filled them in.
+	Templates() map[string]func() string
+}
+
+// builtins returns the built-in generators of a project.
+func builtins(data config.ProjectData) []TemplatePlugin {
+	return []TemplatePlugin{
 		api.NewGenApiPlugin(data),
 		cfg.NewGenConfPlugin(data),
 		db.NewGenDbPlugin(data),
@@ -37,30 +161,97 @@ func ExecuteGeneration(data config.ProjectData) error {
 		scripts.NewGenScriptsPlugin(data),
 		web.NewGenUiPlugin(data),
 	}
+}
+
+// TemplateNames returns the names of the built-in generators as used by general.TemplatesDir, in alphabetical order.
+func TemplateNames() []string {
+	var names []string
+	for _, plugin := range builtins(config.ProjectData{}) {
+		names = append(names, general.TemplateName(plugin.Name()))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BuiltinTemplates returns the built-in templates of the generator called name, ignoring overrides.
+func BuiltinTemplates(name string, data config.ProjectData) (map[string]func() string, error) {
+	for _, plugin := range builtins(data) {
+		if general.TemplateName(plugin.Name()) != name {
+			continue
+		}
+		if err := plugin.Init(); err != nil {
+			return nil, err
+		}
+		return plugin.Templates(), nil
+	}
+	return nil, fmt.Errorf(">>Gost>> unknown generator %q, expected one of: %s", name, strings.Join(TemplateNames(), ", "))
+}
+
+// generate runs the built-in generators and the out-of-process plugins.
+func generate(data config.ProjectData, settings *pmCfg.PluginConfig) error {
+	var generators []plugins.Plugin
+	for _, plugin := range builtins(data) {
+		generators = append(generators, plugin)
+	}
 
 	pmConfig := &pmCfg.PluginManagerConfig{
 		PluginsDir: "plugins",
+		Settings:   settings,
+		Disabled:   general.DisabledPlugins(data),
 	}
 	pm := plugins.NewPluginManager(pmConfig)
 	err := pm.RegisterPlugins(generators)
 	if err != nil {
 		return err
 	}
-	err = pm.InitPlugins()
+	// Out-of-process plugins from PluginsDir and the plugin store can depend on the built-in generators by name.
+	external, err := loader.DiscoverPlugins(pmConfig.PluginsDir, data)
 	if err != nil {
-		fmt.Println(err)
+		return err
 	}
-
-	defer func() {
-		if err := pm.ShutdownPlugins(); err != nil {
-			fmt.Println("Error during plugin shutdown:", err)
-		}
-	}()
-
-	err = pm.ExecutePlugins()
+	if err := pm.RegisterPlugins(external); err != nil {
+		return err
+	}
+	pluginStore, err := store.OpenDefault()
+	if err != nil {
+		return err
+	}
+	installed, err := pluginStore.LoadEnabled(data)
 	if err != nil {
-		fmt.Println(err)
+		return err
+	}
+	if err := pm.RegisterPlugins(installed); err != nil {
+		return err
+	}
+	err = pm.InitPlugins()
+	if err == nil {
+		err = pm.ExecutePlugins()
+	}
+	// Shut down even when init fails half way, so started plugin processes are stopped.
+	if shutdownErr := pm.ShutdownPlugins(); shutdownErr != nil {
+		fmt.Println("Error during plugin shutdown:", shutdownErr)
 	}
+	return err
+}
 
-	return nil
+// verifyStaging formats the staged Go files and reports their syntax errors, strict also type-checks
+// them once they parse.
+func verifyStaging(staged config.ProjectData, target string, strict bool) error {
+	if err := verify.Format(staged.OutputDir); err != nil || !strict {
+		return err
+	}
+	return verify.TypeCheck(staged.OutputDir, target, staged.AppName)
+}
+
+func printConflicts(w io.Writer, conflicts []general.Conflict) {
+	for _, conflict := range conflicts {
+		switch conflict.Policy {
+		case general.ConflictSkip:
+			fmt.Fprintf(w, "  skip       %s, it was changed since it was generated\n", conflict.Path)
+		case general.ConflictNew:
+			fmt.Fprintf(w, "  new        %s%s, %s was changed since it was generated\n", conflict.Path, general.NewFileSuffix, conflict.Path)
+		case general.ConflictOverwrite:
+			fmt.Fprintf(w, "  overwrite  %s, replacing the changes made since it was generated\n", conflict.Path)
+		}
+	}
 }
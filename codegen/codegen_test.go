@@ -0,0 +1,74 @@
+package codegen
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theHamdiz/gost/codegen/general"
+	"github.com/theHamdiz/gost/config"
+)
+
+func readTree(t *testing.T, root string) map[string]string {
+	files := make(map[string]string)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		files[filepath.ToSlash(rel)] = string(content)
+		return err
+	})
+	require.NoError(t, err)
+	return files
+}
+
+func TestReproducibleGeneration(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+
+	generate := func() map[string]string {
+		data := config.ProjectData{
+			AppName:       "blog",
+			ProjectDir:    filepath.Join(t.TempDir(), "blog"),
+			BackendPkg:    "chi",
+			DbDriver:      "sqlite",
+			DbOrm:         "Built In",
+			UiFramework:   "Tailwindcss",
+			ConfigFile:    ".env",
+			Port:          8080,
+			MigrationsDir: "app/db/migrations",
+		}
+		Reproducible(&data)
+		data.Fingerprint = "fingerprint"
+		require.NoError(t, ExecuteGeneration(data, Options{Reproducible: true, Output: io.Discard}))
+		return readTree(t, data.ProjectDir)
+	}
+
+	first, second := generate(), generate()
+	require.NotEmpty(t, first)
+	assert.Equal(t, first, second)
+	assert.Contains(t, first, "app/db/migrations/create_db_1700000000000000000.sql")
+}
+
+func TestEjectedTemplatesAreNotRendered(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range TemplateNames() {
+		files, err := BuiltinTemplates(name, config.ProjectData{})
+		require.NoError(t, err)
+		_, _, err = general.EjectTemplates(filepath.Join(dir, name), files, false)
+		require.NoError(t, err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "conf", "app", "cfg", "cfg.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "{{", "the ejected cfg template is rendered instead of kept as a template")
+}
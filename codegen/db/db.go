@@ -2,7 +2,6 @@ package db
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/theHamdiz/gost/codegen/general"
 	"github.com/theHamdiz/gost/config"
@@ -15,7 +14,7 @@ type GenDbPlugin struct {
 }
 
 func (g *GenDbPlugin) Init() error {
-	now := time.Now().UTC().UnixNano()
+	now := g.Data.Now().UTC().UnixNano()
 	g.Files = map[string]func() string{
 		/*"app/services/db.go": func() string {
 					return `package db
@@ -126,7 +125,11 @@ func (g *GenDbPlugin) GitHub() string {
 }
 
 func (g *GenDbPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenDbPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenDbPlugin(data config.ProjectData) *GenDbPlugin {
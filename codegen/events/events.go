@@ -160,7 +160,11 @@ func (g *GenEventsPlugin) GitHub() string {
 }
 
 func (g *GenEventsPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenEventsPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenEventsPlugin(data config.ProjectData) *GenEventsPlugin {
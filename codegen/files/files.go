@@ -18,28 +18,14 @@ func (g *GenFilesPlugin) Init() error {
 			return `package main
 
 import (
-	{{- if eq .BackendPkg "echo" }}
-	"github.com/labstack/echo/v5"
-	"github.com/labstack/echo/v5/middleware"
-	{{- end }}
     "{{.AppName}}/app/cfg"
     "{{.AppName}}/app/db"
-    {{- if eq .BackendPkg "chi" }}
-    "github.com/go-chi/chi/v5"
-    "github.com/go-chi/chi/v5/middleware"
-    {{- end }}
-    {{- if eq .BackendPkg "gin" }}
-    "github.com/gin-gonic/gin"
-    "github.com/gin-gonic/gin/middleware"
-    {{- end }}
     "log"
-	{{- if eq .BackendPkg "std" }}
-    "net/http"
-	{{- end }}
 	"os"
     "os/signal"
     "syscall"
     "{{.AppName}}/app/router"
+    "{{.AppName}}/app/router/routing"
 )
 
 func waitForShutdown() {
@@ -54,28 +40,18 @@ func waitForShutdown() {
 }
 
 func main() {
+    c := cfg.LoadConfig()
+    logger.InitLogger()
+    db.InitDB(c)
+    defer db.CloseDB()
+
+    server := router.InitRoutes()
+
     log.Println("Server starting on port", cfg.Port)
     go func() {
-        {{- if eq .BackendPkg "gin" }}
-        if err := server.(*gin.Engine).Run(":" + cfg.Port); err != nil {
-            log.Fatal(err)
-        }
-        {{- end }}
-        {{- if eq .BackendPkg "echo" }}
-        if err := server.(*echo.Echo).Start(":" + cfg.Port); err != nil {
-            log.Fatal(err)
-        }
-        {{- end }}
-        {{- if eq .BackendPkg "chi" }}
-        if err := http.ListenAndServe(":" + cfg.Port, server.(http.Handler)); err != nil {
+        if err := routing.Listen(":" + cfg.Port, server); err != nil {
             log.Fatal(err)
         }
-        {{- end }}
-        {{- if eq .BackendPkg "std" }}
-        if err := http.ListenAndServe(":" + cfg.Port, server.(http.Handler)); err != nil {
-            log.Fatal(err)
-        }
-        {{- end }}
     }()
 
     waitForShutdown()
@@ -86,28 +62,14 @@ func main() {
 			return `package worker
 
 import (
-	{{- if eq .BackendPkg "echo" }}
-	"github.com/labstack/echo/v5"
-	"github.com/labstack/echo/v5/middleware"
-	{{- end }}
     "{{.AppName}}/app/cfg"
     "{{.AppName}}/app/db"
-    {{- if eq .BackendPkg "chi" }}
-    "github.com/go-chi/chi/v5"
-    "github.com/go-chi/chi/v5/middleware"
-    {{- end }}
-    {{- if eq .BackendPkg "gin" }}
-    "github.com/gin-gonic/gin"
-    "github.com/gin-gonic/gin/middleware"
-    {{- end }}
     "log"
-	{{- if eq .BackendPkg "std" }}
-    "net/http"
-	{{- end }}
 	"os"
     "os/signal"
     "syscall"
     "{{.AppName}}/app/router"
+    "{{.AppName}}/app/router/routing"
 )
 
 func waitForShutdown() {
@@ -131,26 +93,9 @@ func main() {
 
     log.Println("Server starting on port", cfg.Port)
     go func() {
-        {{- if eq .BackendPkg "gin" }}
-        if err := server.(*gin.Engine).Run(":" + cfg.Port); err != nil {
-            log.Fatal(err)
-        }
-        {{- end }}
-        {{- if eq .BackendPkg "echo" }}
-        if err := server.(*echo.Echo).Start(":" + cfg.Port); err != nil {
-            log.Fatal(err)
-        }
-        {{- end }}
-        {{- if eq .BackendPkg "chi" }}
-        if err := http.ListenAndServe(":" + cfg.Port, server.(http.Handler)); err != nil {
-            log.Fatal(err)
-        }
-        {{- end }}
-        {{- if eq .BackendPkg "std" }}
-        if err := http.ListenAndServe(":" + cfg.Port, server.(http.Handler)); err != nil {
+        if err := routing.Listen(":" + cfg.Port, server); err != nil {
             log.Fatal(err)
         }
-        {{- end }}
     }()
 
     waitForShutdown()
@@ -559,7 +504,8 @@ func (g *GenFilesPlugin) Version() string {
 }
 
 func (g *GenFilesPlugin) Dependencies() []string {
-	return []string{}
+	// cmd/server imports app/cfg, app/db and app/router.
+	return []string{"GenConfPlugin", "GenDbPlugin", "GenRouterPlugin"}
 }
 
 func (g *GenFilesPlugin) AuthorName() string {
@@ -579,7 +525,11 @@ func (g *GenFilesPlugin) GitHub() string {
 }
 
 func (g *GenFilesPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenFilesPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenFilesPlugin(data config.ProjectData) *GenFilesPlugin {
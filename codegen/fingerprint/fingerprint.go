@@ -2,8 +2,11 @@ package fingerprint
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"time"
 
 	"golang.org/x/crypto/blake2b"
@@ -11,14 +14,17 @@ import (
 
 // Fingerprint creates a BLAKE2b hash composed of appName and the current timestamp
 func Fingerprint(appName string) (string, error) {
-	// Get the current timestamp
-	timestamp := time.Now().UTC().UnixNano()
+	return New(appName, time.Now(), rand.Reader)
+}
+
+// New creates the BLAKE2b hash of appName and now, keyed with 32 bytes read from entropy.
+func New(appName string, now time.Time, entropy io.Reader) (string, error) {
 	// Concatenate appName and timestamp to create the input for hashing
-	input := fmt.Sprintf("%s:%d", appName, timestamp)
+	input := fmt.Sprintf("%s:%d", appName, now.UTC().UnixNano())
 
 	// Generate a random key for the BLAKE2b hash
 	key := make([]byte, 32)
-	_, err := rand.Read(key)
+	_, err := io.ReadFull(entropy, key)
 	if err != nil {
 		return "", fmt.Errorf(">>Gost>> failed to generate random key: %w", err)
 	}
@@ -40,3 +46,32 @@ func Fingerprint(appName string) (string, error) {
 
 	return secret, nil
 }
+
+// Seeded returns an endless stream of bytes derived from seed, the same seed always yields the same bytes.
+// It makes generated secrets reproducible and must not be used for secrets of deployed apps.
+func Seeded(seed string) io.Reader {
+	return &seeded{seed: []byte(seed)}
+}
+
+type seeded struct {
+	seed    []byte
+	counter uint64
+	block   []byte
+}
+
+func (s *seeded) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(s.block) == 0 {
+			var counter [8]byte
+			binary.BigEndian.PutUint64(counter[:], s.counter)
+			s.counter++
+			sum := sha256.Sum256(append(append([]byte{}, s.seed...), counter[:]...))
+			s.block = sum[:]
+		}
+		copied := copy(p[n:], s.block)
+		s.block = s.block[copied:]
+		n += copied
+	}
+	return n, nil
+}
@@ -3,6 +3,7 @@ package fingerprint
 import (
 	"fmt"
 	"testing"
+	"time"
 )
 
 func TestFingerprintingIsDifferentForTheSameAppName(t *testing.T) {
@@ -29,3 +30,27 @@ func TestFingerprintingIsDifferentForTheSameAppName(t *testing.T) {
 		t.Errorf(">>Gost>> Expected %s not to be equal to %s", f1, f2)
 	}
 }
+
+func TestSeededFingerprintIsReproducible(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	f1, err := New("cody", now, Seeded("gost:cody"))
+	if err != nil {
+		t.Fatalf(">>Gost>> Fingerprinting issue: %+v", err)
+	}
+	f2, err := New("cody", now, Seeded("gost:cody"))
+	if err != nil {
+		t.Fatalf(">>Gost>> Fingerprinting issue: %+v", err)
+	}
+	if f1 != f2 {
+		t.Errorf(">>Gost>> Expected %s to be equal to %s", f1, f2)
+	}
+
+	f3, err := New("cody", now, Seeded("gost:other"))
+	if err != nil {
+		t.Fatalf(">>Gost>> Fingerprinting issue: %+v", err)
+	}
+	if f3 == f1 {
+		t.Errorf(">>Gost>> Expected %s not to be equal to %s", f3, f1)
+	}
+}
@@ -0,0 +1,90 @@
+package general
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/theHamdiz/gost/config"
+)
+
+// Feature is a subsystem of the generated project that can be left out with --without.
+// It either disables whole generator plugins or skips the files and directories under Paths.
+type Feature struct {
+	Description string
+	Plugins     []string
+	Paths       []string
+}
+
+// Features are the subsystems that can be left out, keyed by the name used on the command line.
+var Features = map[string]Feature{
+	"api":        {Description: "HTTP and gRPC API servers", Plugins: []string{"GenApiPlugin"}, Paths: []string{"app/api"}},
+	"conf":       {Description: "config loading code", Plugins: []string{"GenConfPlugin"}},
+	"db":         {Description: "database connection code", Plugins: []string{"GenDbPlugin"}},
+	"events":     {Description: "event bus", Plugins: []string{"GenEventsPlugin"}},
+	"files":      {Description: "project files like go.mod, Makefile and .env", Plugins: []string{"GenFilesPlugin"}},
+	"grpc":       {Description: "gRPC server, client and protos", Paths: []string{"app/api/grpc"}},
+	"handlers":   {Description: "request handlers", Plugins: []string{"GenHandlersPlugin"}},
+	"mailer":     {Description: "mailer types", Paths: []string{"app/types/mailer"}},
+	"middleware": {Description: "middleware", Plugins: []string{"GenMiddlewarePlugin"}},
+	"plugins":    {Description: "plugin system of the generated app", Plugins: []string{"GenPluginsPlugin"}, Paths: []string{"plugins"}},
+	"router":     {Description: "router", Plugins: []string{"GenRouterPlugin"}},
+	"scripts":    {Description: "scripts under cmd/scripts", Plugins: []string{"GenScriptsPlugin"}, Paths: []string{"cmd/scripts"}},
+	"services":   {Description: "service container", Plugins: []string{"GenServicesPlugin"}},
+	"types":      {Description: "core types and models", Plugins: []string{"GenTypesPlugin"}},
+	"ui":         {Description: "web frontend and backend pages", Plugins: []string{"GenUiPlugin"}, Paths: []string{"app/web"}},
+}
+
+// FeatureNames returns the feature names in alphabetical order.
+func FeatureNames() []string {
+	names := make([]string, 0, len(Features))
+	for name := range Features {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseFeatures normalizes feature names, accepting comma separated lists, and rejects unknown ones.
+func ParseFeatures(values []string) ([]string, error) {
+	var features []string
+	seen := make(map[string]bool)
+	for _, value := range values {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" || seen[name] {
+				continue
+			}
+			if _, ok := Features[name]; !ok {
+				return nil, fmt.Errorf(">>Gost>> unknown feature %q, expected one of: %s", name, strings.Join(FeatureNames(), ", "))
+			}
+			seen[name] = true
+			features = append(features, name)
+		}
+	}
+	sort.Strings(features)
+	return features, nil
+}
+
+// DisabledPlugins returns the generator plugins turned off by the features data is generated without.
+func DisabledPlugins(data config.ProjectData) []string {
+	var names []string
+	for _, feature := range data.Without {
+		names = append(names, Features[feature].Plugins...)
+	}
+	return names
+}
+
+// Skipped reports whether path, relative to the project root, belongs to a feature data is generated without.
+func Skipped(data config.ProjectData, path string) bool {
+	path = filepath.ToSlash(filepath.Clean(path))
+	for _, feature := range data.Without {
+		for _, prefix := range Features[feature].Paths {
+			if path == prefix || strings.HasPrefix(path, prefix+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -1,9 +1,11 @@
 package general
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/theHamdiz/gost/cleaner"
@@ -16,27 +18,73 @@ type Generator interface {
 	Generate(data config.ProjectData) error
 }
 
+// TemplateError is a generated file that could not be rendered or written.
+type TemplateError struct {
+	Path string
+	Err  error
+}
+
+func (e *TemplateError) Error() string {
+	return fmt.Sprintf("template %s: %v", e.Path, e.Err)
+}
+
+func (e *TemplateError) Unwrap() error {
+	return e.Err
+}
+
+// GenerateFiles renders every template into the project root, in path order.
+// A failing template does not stop the others, all failures are returned as TemplateErrors.
 func GenerateFiles(data config.ProjectData, files map[string]func() string) error {
-	for path, tmplFunc := range files {
-		content, err := parser.ParseTemplateStringAsText(path, tmplFunc(), data)
-		if err != nil {
-			return fmt.Errorf(">>Gost>> failed to parse template %s: %w", path, err)
-		}
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
 
-		appNameDowncased := strings.ToLower(data.AppName)
-		filePath := filepath.Join(appNameDowncased, path)
-		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
-			return fmt.Errorf(">>Gost>> ✗ failed to create directory: %w", err)
+	var errs []error
+	for _, path := range paths {
+		if Skipped(data, path) {
+			continue
 		}
-		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
-			return fmt.Errorf(">>Gost>> failed to write file: %w", err)
-		} else {
-			if strings.HasSuffix(filePath, ".go") {
-				if err = cleaner.SortImports(filePath); err != nil {
-					return fmt.Errorf(">>Gost>> ✗ The file was saved but failed to sort its imports %w", err)
-				}
-			}
+		if err := generateFile(data, path, files[path]); err != nil {
+			errs = append(errs, &TemplateError{Path: path, Err: err})
 		}
 	}
+	return errors.Join(errs...)
+}
+
+func generateFile(data config.ProjectData, path string, tmplFunc func() string) error {
+	content, err := parser.ParseTemplateStringAsText(path, tmplFunc(), data)
+	if err != nil {
+		return fmt.Errorf("failed to parse: %w", err)
+	}
+
+	if strings.HasSuffix(path, ".go") {
+		// Files that do not parse are written as they are, the verification after generating reports them.
+		if organized, err := cleaner.OrganizeImports([]byte(content), data.AppName); err == nil {
+			content = string(organized)
+		}
+	}
+
+	filePath := filepath.Join(ProjectRoot(data), path)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("✗ failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
 	return nil
 }
+
+// ProjectRoot returns the directory generated files are written to: the staging OutputDir while
+// generating, otherwise the project dir, falling back to the down-cased app name relative to the
+// working directory when no project dir is set.
+func ProjectRoot(data config.ProjectData) string {
+	if data.OutputDir != "" {
+		return data.OutputDir
+	}
+	if data.ProjectDir != "" {
+		return data.ProjectDir
+	}
+	return strings.ToLower(data.AppName)
+}
@@ -1 +1,260 @@
 package general
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theHamdiz/gost/config"
+)
+
+func TestParseFeatures(t *testing.T) {
+	features, err := ParseFeatures([]string{"scripts,GRPC", " mailer", "grpc"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"grpc", "mailer", "scripts"}, features)
+
+	_, err = ParseFeatures([]string{"grpc,mail"})
+	assert.ErrorContains(t, err, `unknown feature "mail", expected one of: api, conf`)
+
+	data := config.ProjectData{Without: features}
+	assert.Equal(t, []string{"GenScriptsPlugin"}, DisabledPlugins(data))
+	assert.True(t, Skipped(data, "app/api/grpc/v1/server/server.go"))
+	assert.True(t, Skipped(data, "app/api/grpc"))
+	assert.False(t, Skipped(data, "app/api/grpcx/server.go"))
+	assert.False(t, Skipped(data, "app/api/http/v1/api.go"))
+}
+
+func TestGenerateFilesSkipsFeatures(t *testing.T) {
+	data := config.ProjectData{ProjectDir: t.TempDir(), Without: []string{"mailer"}}
+	err := GenerateFiles(data, map[string]func() string{
+		"app/types/mailer/mailer.go": func() string { return "package mailer\n" },
+		"app/types/core/core.go":     func() string { return "package core\n" },
+	})
+	require.NoError(t, err)
+	assert.NoFileExists(t, filepath.Join(data.ProjectDir, "app/types/mailer/mailer.go"))
+	_, err = os.Stat(filepath.Join(data.ProjectDir, "app/types/core/core.go"))
+	assert.NoError(t, err)
+}
+
+func TestGenerateFilesCollectsTemplateErrors(t *testing.T) {
+	data := config.ProjectData{ProjectDir: t.TempDir(), AppName: "blog"}
+	err := GenerateFiles(data, map[string]func() string{
+		"b.txt": func() string { return "{{ .Missing }}" },
+		"a.txt": func() string { return "{{ if }}" },
+		"c.txt": func() string { return "{{ .AppName }}" },
+	})
+	var templateErr *TemplateError
+	require.ErrorAs(t, err, &templateErr)
+	assert.Equal(t, "a.txt", templateErr.Path)
+	assert.Contains(t, err.Error(), "template b.txt: failed to parse")
+	content, err := os.ReadFile(filepath.Join(data.ProjectDir, "c.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "blog", string(content))
+}
+
+func TestStagingCommit(t *testing.T) {
+	project := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(project, "go.mod"), []byte("old"), 0644))
+
+	data, err := NewStagingDir(config.ProjectData{ProjectDir: project})
+	require.NoError(t, err)
+	defer os.RemoveAll(data.OutputDir)
+	assert.Equal(t, data.OutputDir, ProjectRoot(data))
+
+	require.NoError(t, GenerateFiles(data, map[string]func() string{
+		"go.mod":               func() string { return "new" },
+		"app/router/router.go": func() string { return "package router\n" },
+	}))
+	assert.NoFileExists(t, filepath.Join(project, "app/router/router.go"))
+
+	conflicts, err := CommitStaging(data, Resolve(ConflictOverwrite))
+	require.NoError(t, err)
+	assert.Equal(t, []Conflict{{Path: "go.mod", Policy: ConflictOverwrite}}, conflicts)
+	content, err := os.ReadFile(filepath.Join(project, "go.mod"))
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(content))
+	assert.FileExists(t, filepath.Join(project, "app/router/router.go"))
+
+	manifest, err := LoadManifest(project)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"go.mod":               Hash([]byte("new")),
+		"app/router/router.go": Hash([]byte("package router\n")),
+	}, manifest.Files)
+}
+
+func TestCommitStagingConflicts(t *testing.T) {
+	project := t.TempDir()
+	generate := func(resolve ConflictResolver, files map[string]string) []Conflict {
+		data, err := NewStagingDir(config.ProjectData{ProjectDir: project})
+		require.NoError(t, err)
+		defer os.RemoveAll(data.OutputDir)
+		templates := make(map[string]func() string)
+		for path, content := range files {
+			content := content
+			templates[path] = func() string { return content }
+		}
+		require.NoError(t, GenerateFiles(data, templates))
+		conflicts, err := CommitStaging(data, resolve)
+		require.NoError(t, err)
+		return conflicts
+	}
+	read := func(path string) string {
+		content, err := os.ReadFile(filepath.Join(project, path))
+		require.NoError(t, err)
+		return string(content)
+	}
+	files := map[string]string{"a.txt": "a1", "b.txt": "b1", "c.txt": "c1", "d.txt": "d1"}
+	assert.Empty(t, generate(Resolve(ConflictSkip), files))
+
+	// Files nobody touched are regenerated, changed ones go through the policy.
+	for _, path := range []string{"a.txt", "b.txt", "c.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(project, path), []byte("edited"), 0644))
+	}
+	policies := map[string]ConflictPolicy{"a.txt": ConflictSkip, "b.txt": ConflictOverwrite, "c.txt": ConflictNew}
+	conflicts := generate(func(path string) (ConflictPolicy, error) {
+		return policies[path], nil
+	}, map[string]string{"a.txt": "a2", "b.txt": "b2", "c.txt": "c2", "d.txt": "d2"})
+
+	assert.Equal(t, []Conflict{
+		{Path: "a.txt", Policy: ConflictSkip},
+		{Path: "b.txt", Policy: ConflictOverwrite},
+		{Path: "c.txt", Policy: ConflictNew},
+	}, conflicts)
+	assert.Equal(t, "edited", read("a.txt"))
+	assert.Equal(t, "b2", read("b.txt"))
+	assert.Equal(t, "edited", read("c.txt"))
+	assert.Equal(t, "c2", read("c.txt"+NewFileSuffix))
+	assert.Equal(t, "d2", read("d.txt"))
+
+	// Kept changes stay conflicts until they are resolved.
+	conflicts = generate(Resolve(ConflictSkip), map[string]string{"a.txt": "a2", "b.txt": "b2", "c.txt": "c2"})
+	assert.Equal(t, []Conflict{{Path: "a.txt", Policy: ConflictSkip}, {Path: "c.txt", Policy: ConflictSkip}}, conflicts)
+}
+
+func TestParseConflictPolicy(t *testing.T) {
+	policy, err := ParseConflictPolicy("Overwrite")
+	require.NoError(t, err)
+	assert.Equal(t, ConflictOverwrite, policy)
+
+	_, err = ParseConflictPolicy("merge")
+	assert.EqualError(t, err, `>>Gost>> unknown conflict policy "merge", expected one of: skip, overwrite, new, prompt`)
+}
+
+func TestPlanStaging(t *testing.T) {
+	project := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(project, "go.mod"), []byte("module blog\n\ngo 1.21\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(project, "README.md"), []byte("# blog\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(project, "main.go"), []byte("package main\n"), 0644))
+	manifest := &Manifest{Files: make(map[string]string)}
+	manifest.Record("go.mod", []byte("module blog\n\ngo 1.21\n"))
+	require.NoError(t, manifest.Save(project))
+
+	data, err := NewStagingDir(config.ProjectData{ProjectDir: project})
+	require.NoError(t, err)
+	defer os.RemoveAll(data.OutputDir)
+	require.NoError(t, GenerateFiles(data, map[string]func() string{
+		"go.mod":               func() string { return "module blog\n\ngo 1.22\n" },
+		"README.md":            func() string { return "# blog\n" },
+		"app/router/router.go": func() string { return "package router\n" },
+		"main.go":              func() string { return "package main\n\nfunc main() {}\n" },
+	}))
+
+	changes, err := PlanStaging(data)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Path: "README.md", Action: ActionUnchanged, Size: 7, OldSize: 7},
+		{Path: "app/router/router.go", Action: ActionCreate, Size: 15},
+		{Path: "go.mod", Action: ActionModify, Size: 21, OldSize: 21},
+		{Path: "main.go", Action: ActionConflict, Size: 29, OldSize: 13},
+	}, changes)
+
+	var plan strings.Builder
+	require.NoError(t, PrintPlan(&plan, project, changes))
+	assert.Equal(t, "  create    app/router/router.go (15 B)\n"+
+		"  modify    go.mod (21 B -> 21 B)\n"+
+		"  conflict  main.go (13 B -> 29 B)\n"+
+		"Dry run, nothing was written to "+project+": 1 to create, 1 to modify, 1 changed since generated, 1 unchanged\n", plan.String())
+
+	var diff strings.Builder
+	require.NoError(t, PrintDiff(&diff, data, changes))
+	assert.Contains(t, diff.String(), "--- /dev/null\n+++ b/app/router/router.go\n")
+	assert.Contains(t, diff.String(), "--- a/go.mod\n+++ b/go.mod\n")
+	assert.Contains(t, diff.String(), "-go 1.21\n+go 1.22\n")
+	assert.NotContains(t, diff.String(), "README.md")
+
+	assert.NoFileExists(t, filepath.Join(project, "app/router/router.go"))
+}
+
+func TestFormatSize(t *testing.T) {
+	assert.Equal(t, "512 B", formatSize(512))
+	assert.Equal(t, "1.5 KB", formatSize(1536))
+	assert.Equal(t, "2.0 MB", formatSize(2<<20))
+}
+
+func TestOverrideTemplates(t *testing.T) {
+	home, project := t.TempDir(), t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	write := func(root, path, content string) {
+		path = filepath.Join(root, TemplatesDir, "handlers", path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	write(home, "app/handlers/auth.go", "package global\n")
+	write(home, "Makefile", "global: {{.AppName}}\n")
+	write(project, "Makefile", "project: {{.AppName}}\n")
+	write(project, "unknown.go", "package unknown\n")
+
+	data, err := NewStagingDir(config.ProjectData{AppName: "blog", ProjectDir: project})
+	require.NoError(t, err)
+	defer os.RemoveAll(data.OutputDir)
+	require.NoError(t, GeneratePluginFiles("GenHandlersPlugin", data, map[string]func() string{
+		"Makefile":             func() string { return "built-in\n" },
+		"app/handlers/auth.go": func() string { return "package handlers\n" },
+		"app/handlers/home.go": func() string { return "package handlers\n" },
+	}))
+
+	read := func(path string) string {
+		content, err := os.ReadFile(filepath.Join(data.OutputDir, path))
+		require.NoError(t, err)
+		return string(content)
+	}
+	assert.Equal(t, "project: blog\n", read("Makefile"))
+	assert.Equal(t, "package global\n", read("app/handlers/auth.go"))
+	assert.Equal(t, "package handlers\n", read("app/handlers/home.go"))
+	assert.NoFileExists(t, filepath.Join(data.OutputDir, "unknown.go"))
+}
+
+func TestEjectTemplates(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]func() string{
+		"Makefile":             func() string { return "build: {{.AppName}}\n" },
+		"app/handlers/auth.go": func() string { return "package handlers\n" },
+	}
+	written, kept, err := EjectTemplates(dir, files, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Makefile", "app/handlers/auth.go"}, written)
+	assert.Empty(t, kept)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Makefile"), []byte("house style\n"), 0644))
+	written, kept, err = EjectTemplates(dir, files, false)
+	require.NoError(t, err)
+	assert.Empty(t, written)
+	assert.Equal(t, []string{"Makefile", "app/handlers/auth.go"}, kept)
+	content, err := os.ReadFile(filepath.Join(dir, "Makefile"))
+	require.NoError(t, err)
+	assert.Equal(t, "house style\n", string(content))
+
+	written, _, err = EjectTemplates(dir, files, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Makefile", "app/handlers/auth.go"}, written)
+}
+
+func TestTemplateName(t *testing.T) {
+	assert.Equal(t, "handlers", TemplateName("GenHandlersPlugin"))
+	assert.Equal(t, "ui", TemplateName("GenUiPlugin"))
+}
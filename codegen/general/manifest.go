@@ -0,0 +1,108 @@
+package general
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ManifestFile records the hash of every file gost generated, relative to the project root.
+const ManifestFile = ".gost/manifest.json"
+
+// NewFileSuffix is appended to generated files written next to a changed file by ConflictNew.
+const NewFileSuffix = ".gost-new"
+
+// ConflictPolicy decides what happens to a generated file whose project copy was changed since gost wrote it.
+type ConflictPolicy string
+
+const (
+	// ConflictSkip keeps the project file and drops the generated one.
+	ConflictSkip ConflictPolicy = "skip"
+	// ConflictOverwrite replaces the project file.
+	ConflictOverwrite ConflictPolicy = "overwrite"
+	// ConflictNew keeps the project file and writes the generated one next to it with NewFileSuffix.
+	ConflictNew ConflictPolicy = "new"
+	// ConflictPrompt asks for every conflict which of the other policies to apply.
+	ConflictPrompt ConflictPolicy = "prompt"
+)
+
+// ConflictPolicies lists the policies accepted by ParseConflictPolicy.
+var ConflictPolicies = []string{string(ConflictSkip), string(ConflictOverwrite), string(ConflictNew), string(ConflictPrompt)}
+
+// ParseConflictPolicy validates a policy name given on the command line.
+func ParseConflictPolicy(name string) (ConflictPolicy, error) {
+	for _, policy := range ConflictPolicies {
+		if strings.EqualFold(strings.TrimSpace(name), policy) {
+			return ConflictPolicy(policy), nil
+		}
+	}
+	return "", fmt.Errorf(">>Gost>> unknown conflict policy %q, expected one of: %s", name, strings.Join(ConflictPolicies, ", "))
+}
+
+// ConflictResolver returns the policy to apply to the conflicting file at path, it never returns ConflictPrompt.
+type ConflictResolver func(path string) (ConflictPolicy, error)
+
+// Resolve applies policy to every conflict.
+func Resolve(policy ConflictPolicy) ConflictResolver {
+	return func(string) (ConflictPolicy, error) {
+		return policy, nil
+	}
+}
+
+// Manifest maps the slash separated path of every generated file to the hash of the content gost wrote.
+type Manifest struct {
+	Files map[string]string `json:"files"`
+}
+
+// LoadManifest reads the manifest of the project at root, an empty one when the project has none yet.
+func LoadManifest(root string) (*Manifest, error) {
+	manifest := &Manifest{Files: make(map[string]string)}
+	content, err := os.ReadFile(filepath.Join(root, ManifestFile))
+	if os.IsNotExist(err) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(content, manifest); err != nil {
+		return nil, fmt.Errorf(">>Gost>> invalid manifest %s: %w", filepath.Join(root, ManifestFile), err)
+	}
+	if manifest.Files == nil {
+		manifest.Files = make(map[string]string)
+	}
+	return manifest, nil
+}
+
+// Save writes the manifest into the project at root.
+func (m *Manifest) Save(root string) error {
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(root, ManifestFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(content, '\n'), 0644)
+}
+
+// Record remembers content as the generated content of path.
+func (m *Manifest) Record(path string, content []byte) {
+	m.Files[filepath.ToSlash(path)] = Hash(content)
+}
+
+// Modified reports whether content of the project file at path differs from what gost generated there.
+// Files gost never generated count as modified, they were written by hand.
+func (m *Manifest) Modified(path string, content []byte) bool {
+	return m.Files[filepath.ToSlash(path)] != Hash(content)
+}
+
+// Hash returns the hash of content as stored in the manifest.
+func Hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,141 @@
+package general
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/theHamdiz/gost/config"
+)
+
+// Actions a staged file would have on the project.
+const (
+	ActionCreate    = "create"
+	ActionModify    = "modify"
+	ActionUnchanged = "unchanged"
+	// ActionConflict modifies a project file that was changed since it was generated, see ConflictPolicy.
+	ActionConflict = "conflict"
+)
+
+// Change is a staged file compared with the file at the same path in the project.
+type Change struct {
+	Path    string
+	Action  string
+	Size    int64
+	OldSize int64
+}
+
+// PlanStaging compares every file staged in data.OutputDir with the project, in path order.
+func PlanStaging(data config.ProjectData) ([]Change, error) {
+	staged := data.OutputDir
+	data.OutputDir = ""
+	root := ProjectRoot(data)
+	manifest, err := LoadManifest(root)
+	if err != nil {
+		return nil, err
+	}
+	return stagedChanges(staged, root, manifest)
+}
+
+func stagedChanges(staged, root string, manifest *Manifest) ([]Change, error) {
+	var changes []Change
+	err := filepath.WalkDir(staged, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(staged, path)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		change := Change{Path: filepath.ToSlash(rel), Action: ActionCreate, Size: int64(len(content))}
+		existing, err := os.ReadFile(filepath.Join(root, rel))
+		switch {
+		case err == nil && bytes.Equal(existing, content):
+			change.Action, change.OldSize = ActionUnchanged, int64(len(existing))
+		case err == nil && manifest.Modified(rel, existing):
+			change.Action, change.OldSize = ActionConflict, int64(len(existing))
+		case err == nil:
+			change.Action, change.OldSize = ActionModify, int64(len(existing))
+		case !os.IsNotExist(err):
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	return changes, err
+}
+
+// PrintPlan lists the files that would be created or modified with their sizes, followed by a summary.
+func PrintPlan(w io.Writer, root string, changes []Change) error {
+	counts := make(map[string]int)
+	for _, change := range changes {
+		counts[change.Action]++
+		var err error
+		switch change.Action {
+		case ActionCreate:
+			_, err = fmt.Fprintf(w, "  %-8s  %s (%s)\n", change.Action, change.Path, formatSize(change.Size))
+		case ActionModify, ActionConflict:
+			_, err = fmt.Fprintf(w, "  %-8s  %s (%s -> %s)\n", change.Action, change.Path, formatSize(change.OldSize), formatSize(change.Size))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "Dry run, nothing was written to %s: %d to create, %d to modify, %d changed since generated, %d unchanged\n",
+		root, counts[ActionCreate], counts[ActionModify], counts[ActionConflict], counts[ActionUnchanged])
+	return err
+}
+
+// PrintDiff writes a unified diff of every created or modified file, new files are diffed against /dev/null.
+func PrintDiff(w io.Writer, data config.ProjectData, changes []Change) error {
+	staged := data.OutputDir
+	data.OutputDir = ""
+	root := ProjectRoot(data)
+
+	for _, change := range changes {
+		if change.Action == ActionUnchanged {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(staged, change.Path))
+		if err != nil {
+			return err
+		}
+		diff := difflib.UnifiedDiff{
+			B:        difflib.SplitLines(string(content)),
+			FromFile: "/dev/null",
+			ToFile:   "b/" + change.Path,
+			Context:  3,
+		}
+		if change.Action != ActionCreate {
+			existing, err := os.ReadFile(filepath.Join(root, change.Path))
+			if err != nil {
+				return err
+			}
+			diff.A = difflib.SplitLines(string(existing))
+			diff.FromFile = "a/" + change.Path
+		}
+		if err := difflib.WriteUnifiedDiff(w, diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatSize(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
@@ -0,0 +1,114 @@
+package general
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/theHamdiz/gost/config"
+)
+
+// NewStagingDir creates an empty directory for data's generated files and returns data writing into it.
+// The files reach the project with CommitStaging, or are dropped with os.RemoveAll.
+func NewStagingDir(data config.ProjectData) (config.ProjectData, error) {
+	dir, err := os.MkdirTemp("", "gost-staging-")
+	if err != nil {
+		return data, err
+	}
+	data.OutputDir = dir
+	return data, nil
+}
+
+// Conflict is a staged file that was changed in the project since it was generated, and the policy applied to it.
+type Conflict struct {
+	Path   string
+	Policy ConflictPolicy
+}
+
+// CommitStaging moves the staged files of data into the project root and records them in the manifest.
+// Files that are unchanged since gost generated them are replaced, resolve decides for the others.
+func CommitStaging(data config.ProjectData, resolve ConflictResolver) ([]Conflict, error) {
+	staged := data.OutputDir
+	data.OutputDir = ""
+	root := ProjectRoot(data)
+	manifest, err := LoadManifest(root)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := stagedChanges(staged, root, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []Conflict
+	for _, change := range changes {
+		src := filepath.Join(staged, change.Path)
+		target := filepath.Join(root, change.Path)
+		if change.Action == ActionConflict {
+			policy, err := resolve(change.Path)
+			if err != nil {
+				return conflicts, err
+			}
+			conflicts = append(conflicts, Conflict{Path: change.Path, Policy: policy})
+			switch policy {
+			case ConflictSkip:
+				continue
+			case ConflictNew:
+				if err := moveFile(src, target+NewFileSuffix); err != nil {
+					return conflicts, err
+				}
+				continue
+			case ConflictOverwrite:
+			default:
+				return conflicts, fmt.Errorf(">>Gost>> cannot apply conflict policy %q to %s", policy, change.Path)
+			}
+		}
+
+		content, err := os.ReadFile(src)
+		if err != nil {
+			return conflicts, err
+		}
+		if change.Action != ActionUnchanged {
+			if err := moveFile(src, target); err != nil {
+				return conflicts, err
+			}
+		}
+		manifest.Record(change.Path, content)
+	}
+	return conflicts, manifest.Save(root)
+}
+
+// moveFile moves src to dst, creating the directories dst is in.
+func moveFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	// Renaming fails across file systems, the temp dir often is one.
+	return copyFile(src, dst)
+}
+
+func copyFile(src, dst string) (err error) {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, out.Close())
+	}()
+	_, err = io.Copy(out, in)
+	return err
+}
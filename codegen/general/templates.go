@@ -0,0 +1,89 @@
+package general
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/theHamdiz/gost/config"
+)
+
+// TemplatesDir holds template overrides, under the project root and under the home directory.
+// Overrides of a plugin's templates live in TemplatesDir/<TemplateName(plugin)>/<generated path>.
+const TemplatesDir = ".gost/templates"
+
+// TemplateName returns the directory of a built-in plugin's template overrides, GenHandlersPlugin becomes handlers.
+func TemplateName(plugin string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(plugin, "Gen"), "Plugin"))
+}
+
+// TemplateDirs returns the directories searched for overrides of plugin's templates, the project's before the user's.
+func TemplateDirs(plugin string, data config.ProjectData) []string {
+	name := TemplateName(plugin)
+	data.OutputDir = ""
+	dirs := []string{filepath.Join(ProjectRoot(data), TemplatesDir, name)}
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, filepath.Join(home, TemplatesDir, name))
+	}
+	return dirs
+}
+
+// OverrideTemplates returns files with every template that has an override on disk replaced by it.
+// Only paths the plugin generates can be overridden, so overrides follow the plugin's conditions.
+func OverrideTemplates(plugin string, data config.ProjectData, files map[string]func() string) (map[string]func() string, error) {
+	dirs := TemplateDirs(plugin, data)
+	overridden := make(map[string]func() string, len(files))
+	for path, tmplFunc := range files {
+		overridden[path] = tmplFunc
+		for _, dir := range dirs {
+			content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("template override %s: %w", filepath.Join(dir, path), err)
+			}
+			tmpl := string(content)
+			overridden[path] = func() string { return tmpl }
+			break
+		}
+	}
+	return overridden, nil
+}
+
+// GeneratePluginFiles renders plugin's files like GenerateFiles, using the template overrides found on disk.
+func GeneratePluginFiles(plugin string, data config.ProjectData, files map[string]func() string) error {
+	files, err := OverrideTemplates(plugin, data, files)
+	if err != nil {
+		return err
+	}
+	return GenerateFiles(data, files)
+}
+
+// EjectTemplates writes the templates in files into dir so they can be edited as overrides,
+// in path order. Existing files are kept unless force is set, the written and the kept paths are returned.
+func EjectTemplates(dir string, files map[string]func() string, force bool) (written, kept []string, err error) {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		target := filepath.Join(dir, filepath.FromSlash(path))
+		if _, err := os.Stat(target); err == nil && !force {
+			kept = append(kept, path)
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return written, kept, err
+		}
+		if err := os.WriteFile(target, []byte(files[path]()), 0644); err != nil {
+			return written, kept, err
+		}
+		written = append(written, path)
+	}
+	return written, kept, nil
+}
@@ -0,0 +1,193 @@
+package codegen
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theHamdiz/gost/config"
+)
+
+// update regenerates the golden trees instead of comparing against them: go test ./codegen -run TestGolden -update
+var update = flag.Bool("update", false, "regenerate the golden trees in testdata/golden")
+
+// goldenDir holds one tree per project in goldenProjects. Every file is stored with goldenSuffix so the
+// generated .gitignore and go.mod files don't apply to the repository.
+const (
+	goldenDir    = "testdata/golden"
+	goldenSuffix = ".golden"
+)
+
+// goldenBackends are the backend fields the create command fills in, with pinned versions.
+var goldenBackends = []config.ProjectData{
+	{BackendPkg: "gin", BackendImport: "github.com/gin-gonic/gin", BackendInit: "gin.Default()", VersionedBackendImport: "github.com/gin-gonic/gin v1.10.0"},
+	{BackendPkg: "chi", BackendImport: "github.com/go-chi/chi/v5", BackendInit: "chi.NewRouter()", VersionedBackendImport: "github.com/go-chi/chi/v5 v5.1.0"},
+	{BackendPkg: "echo", BackendImport: "github.com/labstack/echo/v5", BackendInit: "echo.New()", VersionedBackendImport: "github.com/labstack/echo/v5 v5.0.0-20230722203903-ec5b858dab61"},
+	{BackendPkg: "fiber", BackendImport: "github.com/gofiber/fiber/v2", BackendInit: "fiber.New()", VersionedBackendImport: "github.com/gofiber/fiber/v2 v2.52.5"},
+	{BackendPkg: "stdlib", BackendImport: "net/http", BackendInit: "http.NewServeMux()"},
+}
+
+var (
+	goldenDrivers = []string{"sqlite", "postgres"}
+	goldenFormats = []string{"env", "json", "toml", "yaml"}
+)
+
+// goldenProjects returns every combination of backend, db driver and config format by the name of its golden tree.
+func goldenProjects() map[string]config.ProjectData {
+	projects := make(map[string]config.ProjectData)
+	for _, backend := range goldenBackends {
+		for _, driver := range goldenDrivers {
+			for _, format := range goldenFormats {
+				data := backend
+				data.AppName = "blog"
+				data.DbDriver = driver
+				data.DbOrm = "Built In"
+				data.PreferredConfigFormat = format
+				data.ConfigFile = config.ConfigFileName(format)
+				data.UiFramework = "Tailwindcss"
+				data.ComponentsFramework = "None"
+				data.Port = 8080
+				data.IncludeAuth = true
+				data.MigrationsDir = "app/db/migrations"
+				projects[fmt.Sprintf("%s-%s-%s", backend.BackendPkg, driver, format)] = data
+			}
+		}
+	}
+	return projects
+}
+
+func TestGolden(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+
+	projects := goldenProjects()
+	names := make([]string, 0, len(projects))
+	for name := range projects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		data := projects[name]
+		t.Run(name, func(t *testing.T) {
+			data.ProjectDir = filepath.Join(t.TempDir(), data.AppName)
+			Reproducible(&data)
+			data.Fingerprint = "fingerprint"
+			require.NoError(t, ExecuteGeneration(data, Options{Reproducible: true, Output: io.Discard}))
+
+			got := readTree(t, data.ProjectDir)
+			dir := filepath.Join(goldenDir, name)
+			if *update {
+				writeGolden(t, dir, got)
+				return
+			}
+			want := readGolden(t, dir)
+			assertTree(t, want, got)
+		})
+	}
+}
+
+// compiledPackages are the generated packages TestGoldenRouting builds on every golden backend.
+var compiledPackages = []string{"app/router/routing/", "app/types/gost/"}
+
+// TestGoldenRouting runs the conformance test generated into app/router/routing of every golden backend,
+// so the same route table is checked to behave the same on each of them, and vets the packages built on it.
+// Backends whose module can't be downloaded are skipped.
+func TestGoldenRouting(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs go test on the generated code")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go is not in PATH")
+	}
+	for _, backend := range goldenBackends {
+		t.Run(backend.BackendPkg, func(t *testing.T) {
+			dir := t.TempDir()
+			golden := readGolden(t, filepath.Join(goldenDir, backend.BackendPkg+"-sqlite-env"))
+			for path, content := range golden {
+				for _, pkg := range compiledPackages {
+					if strings.HasPrefix(path, pkg) {
+						target := filepath.Join(dir, filepath.FromSlash(path))
+						require.NoError(t, os.MkdirAll(filepath.Dir(target), 0755))
+						require.NoError(t, os.WriteFile(target, []byte(content), 0644))
+					}
+				}
+			}
+			mod := "module blog\n\ngo 1.22\n"
+			if backend.VersionedBackendImport != "" {
+				mod += "\nrequire " + backend.VersionedBackendImport + "\n"
+			}
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(mod), 0644))
+
+			if out, err := goCommand(dir, "mod", "tidy").CombinedOutput(); err != nil {
+				t.Skipf("the dependencies of the generated code are not available: %s", out)
+			}
+			out, err := goCommand(dir, "vet", "./...").CombinedOutput()
+			require.NoError(t, err, "the generated code does not compile on %s:\n%s", backend.BackendPkg, out)
+			out, err = goCommand(dir, "test", "./...").CombinedOutput()
+			assert.NoError(t, err, "the generated routing conformance test fails on %s:\n%s", backend.BackendPkg, out)
+		})
+	}
+}
+
+func goCommand(dir string, args ...string) *exec.Cmd {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+	return cmd
+}
+
+// readGolden returns the golden tree in dir keyed by the generated paths.
+func readGolden(t *testing.T, dir string) map[string]string {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		t.Fatalf("no golden tree in %s, run go test ./codegen -run TestGolden -update", dir)
+	}
+	files := make(map[string]string)
+	for path, content := range readTree(t, dir) {
+		files[strings.TrimSuffix(path, goldenSuffix)] = content
+	}
+	return files
+}
+
+func writeGolden(t *testing.T, dir string, files map[string]string) {
+	require.NoError(t, os.RemoveAll(dir))
+	for path, content := range files {
+		target := filepath.Join(dir, filepath.FromSlash(path)+goldenSuffix)
+		require.NoError(t, os.MkdirAll(filepath.Dir(target), 0755))
+		require.NoError(t, os.WriteFile(target, []byte(content), 0644))
+	}
+}
+
+// assertTree reports the files missing from got, the unexpected ones and every file whose content differs.
+func assertTree(t *testing.T, want, got map[string]string) {
+	for path := range want {
+		if _, ok := got[path]; !ok {
+			t.Errorf("%s was not generated", path)
+		}
+	}
+	for _, path := range sortedPaths(got) {
+		expected, ok := want[path]
+		if !ok {
+			t.Errorf("%s was generated but is not in the golden tree", path)
+			continue
+		}
+		assert.Equal(t, expected, got[path], "%s differs from the golden tree, run with -update if the change is intended", path)
+	}
+}
+
+func sortedPaths(files map[string]string) []string {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
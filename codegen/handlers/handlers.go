@@ -181,7 +181,11 @@ func (g *GenHandlersPlugin) GitHub() string {
 }
 
 func (g *GenHandlersPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenHandlersPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenHandlersPlugin(data config.ProjectData) *GenHandlersPlugin {
@@ -284,7 +284,11 @@ func (g *GenMiddlewarePlugin) GitHub() string {
 }
 
 func (g *GenMiddlewarePlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenMiddlewarePlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenMiddlewarePlugin(data config.ProjectData) *GenMiddlewarePlugin {
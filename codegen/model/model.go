@@ -0,0 +1,326 @@
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/theHamdiz/gost/codegen/general"
+	"github.com/theHamdiz/gost/config"
+	"github.com/theHamdiz/gost/dialect"
+	"github.com/theHamdiz/gost/inflect"
+	"github.com/theHamdiz/gost/parser"
+)
+
+// kindAliases maps the field types accepted on the command line to gost field kinds.
+var kindAliases = map[string]string{
+	"string":     "string",
+	"varchar":    "string",
+	"text":       "text",
+	"int":        "int",
+	"integer":    "int",
+	"bigint":     "bigint",
+	"int64":      "bigint",
+	"float":      "float",
+	"double":     "float",
+	"decimal":    "decimal",
+	"bool":       "bool",
+	"boolean":    "bool",
+	"time":       "time",
+	"datetime":   "time",
+	"timestamp":  "time",
+	"date":       "date",
+	"uuid":       "uuid",
+	"json":       "json",
+	"jsonb":      "json",
+	"ref":        "ref",
+	"references": "ref",
+	"belongs_to": "ref",
+}
+
+var goTypes = map[string]string{
+	"string":  "string",
+	"text":    "string",
+	"int":     "int",
+	"bigint":  "int64",
+	"float":   "float64",
+	"decimal": "float64",
+	"bool":    "bool",
+	"time":    "time.Time",
+	"date":    "time.Time",
+	"uuid":    "string",
+	"json":    "string",
+	"ref":     "int64",
+}
+
+// Field is a single model attribute parsed from the name:type[:modifiers] field DSL.
+type Field struct {
+	// Column is the snake_case column name.
+	Column string
+	// Kind is the normalized gost field kind, see kindAliases.
+	Kind string
+	// Ref is the referenced model name for ref fields, e.g. User for author_id:ref:User.
+	Ref      string
+	Unique   bool
+	Nullable bool
+}
+
+// GoName returns the struct field name of the field.
+func (f Field) GoName() string {
+	return inflect.Pascal(f.Column)
+}
+
+// GoType returns the Go type of the field, nullable fields become pointers.
+func (f Field) GoType() string {
+	if f.Nullable {
+		return "*" + goTypes[f.Kind]
+	}
+	return goTypes[f.Kind]
+}
+
+// JSONName returns the camelCase json key of the field.
+func (f Field) JSONName() string {
+	return inflect.Camel(f.Column)
+}
+
+// RefTable returns the table referenced by a ref field.
+func (f Field) RefTable() string {
+	return inflect.Pluralize(inflect.Snake(f.Ref))
+}
+
+// ParseFields parses fields written as name:type[:modifiers], e.g.
+//
+//	title:string body:text published:bool author_id:ref:User email:string:unique bio:text:null
+//
+// A field without a type defaults to string.
+func ParseFields(args []string) ([]Field, error) {
+	fields := make([]Field, 0, len(args))
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		parts := strings.Split(arg, ":")
+		field := Field{Column: inflect.Snake(parts[0]), Kind: "string"}
+		if field.Column == "" {
+			return nil, fmt.Errorf(">>Gost>> invalid field %q: missing name", arg)
+		}
+		if len(parts) > 1 {
+			kind, ok := kindAliases[strings.ToLower(parts[1])]
+			if !ok {
+				return nil, fmt.Errorf(">>Gost>> invalid field %q: unknown type %q", arg, parts[1])
+			}
+			field.Kind = kind
+		}
+
+		modifiers := parts[min(len(parts), 2):]
+		if field.Kind == "ref" {
+			if len(modifiers) == 0 || modifiers[0] == "" {
+				return nil, fmt.Errorf(">>Gost>> invalid field %q: ref fields need a model, e.g. %s:ref:User", arg, parts[0])
+			}
+			field.Ref = inflect.Pascal(modifiers[0])
+			modifiers = modifiers[1:]
+		}
+		for _, modifier := range modifiers {
+			switch strings.ToLower(modifier) {
+			case "unique":
+				field.Unique = true
+			case "null", "nullable", "optional":
+				field.Nullable = true
+			default:
+				return nil, fmt.Errorf(">>Gost>> invalid field %q: unknown modifier %q", arg, modifier)
+			}
+		}
+
+		if field.Column == "id" || field.Column == "created_at" || field.Column == "updated_at" {
+			return nil, fmt.Errorf(">>Gost>> invalid field %q: %s is added to every model automatically", arg, field.Column)
+		}
+		if seen[field.Column] {
+			return nil, fmt.Errorf(">>Gost>> duplicate field %q", field.Column)
+		}
+		seen[field.Column] = true
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// Model describes a model to be generated.
+type Model struct {
+	Name   string
+	Table  string
+	Fields []Field
+}
+
+// NewModel builds a Model from a (possibly plural or snake_case) name and its parsed fields.
+func NewModel(name string, fields []Field) Model {
+	pascal := inflect.Pascal(inflect.Singularize(name))
+	return Model{
+		Name:   pascal,
+		Table:  inflect.Pluralize(inflect.Snake(pascal)),
+		Fields: fields,
+	}
+}
+
+const modelTemplate = `package models
+
+import "time"
+
+// {{.Name}} maps to the {{.Table}} table.
+type {{.Name}} struct {
+	ID int64 ` + "`db:\"id\" json:\"id\"`" + `
+{{- range .Fields}}
+	{{.GoName}} {{.GoType}} ` + "`db:\"{{.Column}}\" json:\"{{.JSONName}}\"`" + `
+{{- end}}
+	CreatedAt time.Time ` + "`db:\"created_at\" json:\"createdAt\"`" + `
+	UpdatedAt time.Time ` + "`db:\"updated_at\" json:\"updatedAt\"`" + `
+}
+
+// TableName returns the table backing {{.Name}}.
+func (m *{{.Name}}) TableName() string {
+	return "{{.Table}}"
+}
+
+// ColumnMappings maps the insertable {{.Name}} fields to their columns, the id is left to the database.
+func (m *{{.Name}}) ColumnMappings() map[string]string {
+	return map[string]string{
+{{- range .Fields}}
+		"{{.GoName}}": "{{.Column}}",
+{{- end}}
+		"CreatedAt": "created_at",
+		"UpdatedAt": "updated_at",
+	}
+}
+`
+
+// Source renders the Go source of the model.
+func (m Model) Source() (string, error) {
+	tmpl, err := template.New(m.Name).Funcs(parser.Funcs()).Parse(modelTemplate)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, m); err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf(">>Gost>> generated model %s is not valid go: %w", m.Name, err)
+	}
+	return string(src), nil
+}
+
+// Migration renders the up/down migration creating the model's table in the given dialect.
+func (m Model) Migration(d *dialect.Dialect) (string, error) {
+	columns := []string{"id " + d.PrimaryKey}
+	var constraints []string
+	for _, f := range m.Fields {
+		colType, err := d.ColumnType(f.Kind)
+		if err != nil {
+			return "", err
+		}
+		column := f.Column + " " + colType
+		if !f.Nullable {
+			column += " NOT NULL"
+		}
+		if f.Unique {
+			column += " UNIQUE"
+		}
+		columns = append(columns, column)
+		if f.Kind == "ref" {
+			constraints = append(constraints, fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s(id)", f.Column, f.RefTable()))
+		}
+	}
+	timeType, _ := d.ColumnType("time")
+	columns = append(columns,
+		fmt.Sprintf("created_at %s NOT NULL DEFAULT %s", timeType, d.Now),
+		fmt.Sprintf("updated_at %s NOT NULL DEFAULT %s", timeType, d.Now),
+	)
+	columns = append(columns, constraints...)
+
+	var b strings.Builder
+	b.WriteString("-- +gost Up\n")
+	fmt.Fprintf(&b, "CREATE TABLE IF NOT EXISTS %s (\n    %s\n);\n\n", m.Table, strings.Join(columns, ",\n    "))
+	b.WriteString("-- +gost Down\n")
+	fmt.Fprintf(&b, "DROP TABLE IF EXISTS %s;\n", m.Table)
+	return b.String(), nil
+}
+
+type GenModelPlugin struct {
+	Files map[string]func() string
+	Data  config.ProjectData
+	Model Model
+}
+
+func (g *GenModelPlugin) Init() error {
+	d, err := dialect.For(g.Data.DbDriver)
+	if err != nil {
+		return err
+	}
+	src, err := g.Model.Source()
+	if err != nil {
+		return err
+	}
+	migration, err := g.Model.Migration(d)
+	if err != nil {
+		return err
+	}
+
+	now := g.Data.Now().UTC().UnixNano()
+	g.Files = map[string]func() string{
+		path.Join("app/types/models", inflect.Snake(g.Model.Name)+".go"): func() string {
+			return src
+		},
+		path.Join(g.Data.MigrationsDir, fmt.Sprintf("create_%s_%d.sql", g.Model.Table, now)): func() string {
+			return migration
+		},
+	}
+	return nil
+}
+
+func (g *GenModelPlugin) Execute() error {
+	return g.Generate(g.Data)
+}
+
+func (g *GenModelPlugin) Shutdown() error {
+	// Any cleanup logic for the plugin
+	return nil
+}
+
+func (g *GenModelPlugin) Name() string {
+	return "GenModelPlugin"
+}
+
+func (g *GenModelPlugin) Version() string {
+	return "1.0.0"
+}
+
+func (g *GenModelPlugin) Dependencies() []string {
+	return []string{}
+}
+
+func (g *GenModelPlugin) AuthorName() string {
+	return "Ahmad Hamdi"
+}
+
+func (g *GenModelPlugin) AuthorEmail() string {
+	return "contact@hamdiz.me"
+}
+
+func (g *GenModelPlugin) Website() string {
+	return "https://hamdiz.me"
+}
+
+func (g *GenModelPlugin) GitHub() string {
+	return "https://github.com/theHamdiz/gost/gen/model"
+}
+
+func (g *GenModelPlugin) Generate(data config.ProjectData) error {
+	return general.GenerateFiles(data, g.Files)
+}
+
+func NewGenModelPlugin(data config.ProjectData, model Model) *GenModelPlugin {
+	return &GenModelPlugin{
+		Data:  data,
+		Model: model,
+	}
+}
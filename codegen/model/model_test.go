@@ -0,0 +1,50 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/theHamdiz/gost/dialect"
+)
+
+func TestParseFields(t *testing.T) {
+	fields, err := ParseFields([]string{"title:string", "body:text", "published:bool", "author_id:ref:User", "bio:text:null", "slug:string:unique", "name"})
+	assert.NoError(t, err)
+	assert.Equal(t, []Field{
+		{Column: "title", Kind: "string"},
+		{Column: "body", Kind: "text"},
+		{Column: "published", Kind: "bool"},
+		{Column: "author_id", Kind: "ref", Ref: "User"},
+		{Column: "bio", Kind: "text", Nullable: true},
+		{Column: "slug", Kind: "string", Unique: true},
+		{Column: "name", Kind: "string"},
+	}, fields)
+
+	for _, invalid := range []string{"title:strnig", "author_id:ref", "id:int", "title:string:sometimes"} {
+		_, err := ParseFields([]string{invalid})
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestModelSourceAndMigration(t *testing.T) {
+	fields, err := ParseFields([]string{"title:string", "author_id:ref:User"})
+	assert.NoError(t, err)
+	m := NewModel("posts", fields)
+	assert.Equal(t, "Post", m.Name)
+	assert.Equal(t, "posts", m.Table)
+
+	src, err := m.Source()
+	assert.NoError(t, err)
+	assert.Contains(t, src, "AuthorID  int64     `db:\"author_id\" json:\"authorId\"`")
+	assert.Contains(t, src, `func (m *Post) TableName() string`)
+
+	d, err := dialect.For("Sqlite")
+	assert.NoError(t, err)
+	migration, err := m.Migration(d)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(migration, "-- +gost Up\n"))
+	assert.Contains(t, migration, "id INTEGER PRIMARY KEY AUTOINCREMENT")
+	assert.Contains(t, migration, "FOREIGN KEY (author_id) REFERENCES users(id)")
+	assert.Contains(t, migration, "-- +gost Down\nDROP TABLE IF EXISTS posts;")
+}
@@ -1785,7 +1785,11 @@ func (g *GenPluginsPlugin) GitHub() string {
 }
 
 func (g *GenPluginsPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenPluginsPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenPluginsPlugin(data config.ProjectData) *GenPluginsPlugin {
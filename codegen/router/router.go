@@ -17,67 +17,602 @@ func (g *GenRouterPlugin) Init() error {
 			return `package router
 
 import (
-    "{{.AppName}}/app/handlers"
+    "net/http"
+
+    handlers "{{.AppName}}/app/handlers/frontend"
     "{{.AppName}}/app/middleware"
-    "{{.AppName}}/app/types/core"
-    "log"
-    {{if eq .BackendPkg "chi"}}
-    "github.com/go-chi/chi/v5/middleware"
-    {{end}}
-    {{if eq .BackendPkg "echo"}}
-    "github.com/labstack/echo/v5/middleware"
-    {{end}}
+    "{{.AppName}}/app/router/routing"
 )
 
-func InitializeMiddleware(router prelude.Router) {
-    router.Use(middleware.Logger)
-    router.Use(middleware.Recover)
-    router.Use(middleware.WithRequestURL)			
+func InitializeMiddleware(router routing.Router) {
+    router.Use(middleware.Recoverer, middleware.RequestID, middleware.Logger)
 }
 
-func InitializeRoutes(router prelude.Router) {
-    {{if .IncludeAuth}}
-    authConfig := prelude.AuthConfig{
-        AuthFunc:    authenticateUser,
-        RedirectURL: "/login",
-    }
-
-    router.Group(func(r prelude.Router) {
-        r.Use(prelude.WithAuth(authConfig, false))
-
-        r.Get("/", handlers.HomeHandler)
-        r.Get("/about", handlers.AboutHandler)
-        r.Get("/signin", handlers.SigninHandler)
-        r.Get("/signup", handlers.SignupHandler)
-    })
-
-    router.Group(func(r prelude.Router) {
-        r.Use(prelude.WithAuth(authConfig, true))
+func InitializeRoutes(router routing.Router) {
+    router.NotFound(http.NotFound)
 
-        // r.Get("/path", handlers.SomeProtectedHandler)
-    })
-    {{else}}
     router.Get("/", handlers.HomeHandler)
     router.Get("/about", handlers.AboutHandler)
-    router.Get("/signin", handlers.SigninHandler)
-    router.Get("/signup", handlers.SignupHandler)
-    {{end}}
+    router.Get("/signin", handlers.SignInHandler)
+    router.Get("/signup", handlers.SignUpHandler)
+    {{- if .IncludeAuth}}
 
-    router.NotFound(handlers.NotFoundHandler)
-}
+    // The routes of this group need an authenticated request, see middleware.Auth.
+    router.Group("", func(r routing.Router) {
+        r.Use(middleware.Auth)
 
-{{if .IncludeAuth}}
-func authenticateUser(g *prelude.Gost) (prelude.Auth, error) {
-    return prelude.DefaultAuth{}, nil
+        // r.Get("/path", handlers.SomeProtectedHandler)
+    })
+    {{- end}}
 }
-{{end}}
 
-func InitRoutes(backend string) prelude.Router {
-    router := prelude.NewRouter(backend)
+func InitRoutes() routing.Router {
+    router := routing.New()
     InitializeMiddleware(router)
     InitializeRoutes(router)
     return router
 }
+`
+		},
+		"app/router/routing/routing.go": func() string {
+			return `// Package routing registers the net/http handlers and middlewares of the project on its web framework.
+// The same route table behaves the same whatever the framework is, routing_test.go checks it.
+package routing
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Middleware wraps a handler, like the ones in app/middleware.
+type Middleware = func(http.Handler) http.Handler
+
+// Router -> routes requests to net/http handlers. Paths name their parameters with {name},
+// handlers read them with r.PathValue(name) on every backend.
+type Router interface {
+	http.Handler
+	Get(path string, handler http.HandlerFunc)
+	Post(path string, handler http.HandlerFunc)
+	Put(path string, handler http.HandlerFunc)
+	Patch(path string, handler http.HandlerFunc)
+	Delete(path string, handler http.HandlerFunc)
+	// Group calls fn with a router for the routes under prefix, the middlewares it uses only apply to them.
+	Group(prefix string, fn func(r Router))
+	// Use adds middlewares to the routes registered after it, the first one added runs first.
+	Use(middlewares ...Middleware)
+	// NotFound handles the requests no route matches. A path with routes for other methods
+	// gets 405 Method Not Allowed instead.
+	NotFound(handler http.HandlerFunc)
+	// Static serves the files of fs under prefix, a path like /assets without a trailing slash.
+	Static(prefix string, fs http.FileSystem)
+	// Mount passes the requests under prefix to handler, whatever their method, with prefix
+	// removed from their path. Like for Static, prefix has no trailing slash.
+	Mount(prefix string, handler http.Handler)
+}
+
+// backend is the web framework the routes run on, implemented once per framework in backend.go.
+type backend interface {
+	http.Handler
+	// handle registers handler for method and path. The parameters named in params are
+	// set on the request with SetPathValue before handler runs.
+	handle(method, path string, params []string, handler http.Handler)
+	notFound(handler http.Handler)
+	// mount passes every request under prefix to handler, whatever its method.
+	mount(prefix string, handler http.Handler)
+}
+
+// router applies the prefix and middlewares of a group itself, so they work the same on every backend.
+type router struct {
+	backend     backend
+	prefix      string
+	middlewares []Middleware
+}
+
+func (r *router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.backend.ServeHTTP(w, req)
+}
+
+func (r *router) Get(path string, handler http.HandlerFunc) {
+	r.handle(http.MethodGet, path, handler)
+}
+
+func (r *router) Post(path string, handler http.HandlerFunc) {
+	r.handle(http.MethodPost, path, handler)
+}
+
+func (r *router) Put(path string, handler http.HandlerFunc) {
+	r.handle(http.MethodPut, path, handler)
+}
+
+func (r *router) Patch(path string, handler http.HandlerFunc) {
+	r.handle(http.MethodPatch, path, handler)
+}
+
+func (r *router) Delete(path string, handler http.HandlerFunc) {
+	r.handle(http.MethodDelete, path, handler)
+}
+
+func (r *router) Group(prefix string, fn func(r Router)) {
+	fn(&router{
+		backend:     r.backend,
+		prefix:      r.prefix + prefix,
+		middlewares: append([]Middleware(nil), r.middlewares...),
+	})
+}
+
+func (r *router) Use(middlewares ...Middleware) {
+	r.middlewares = append(r.middlewares, middlewares...)
+}
+
+func (r *router) NotFound(handler http.HandlerFunc) {
+	r.backend.notFound(r.wrap(handler))
+}
+
+func (r *router) Static(prefix string, fs http.FileSystem) {
+	r.Mount(prefix, http.FileServer(fs))
+}
+
+func (r *router) Mount(prefix string, handler http.Handler) {
+	prefix = r.prefix + prefix
+	r.backend.mount(prefix, r.wrap(http.StripPrefix(prefix, handler)))
+}
+
+func (r *router) handle(method, path string, handler http.Handler) {
+	path = r.prefix + path
+	r.backend.handle(method, path, params(path), r.wrap(handler))
+}
+
+// wrap wraps handler in the middlewares used so far.
+func (r *router) wrap(handler http.Handler) http.Handler {
+	for i := len(r.middlewares) - 1; i >= 0; i-- {
+		handler = r.middlewares[i](handler)
+	}
+	return handler
+}
+
+// Listen serves router on addr, with the server of its backend when the backend has one of its own.
+func Listen(addr string, r Router) error {
+	if rt, ok := r.(*router); ok {
+		if server, ok := rt.backend.(interface{ Listen(addr string) error }); ok {
+			return server.Listen(addr)
+		}
+	}
+	return http.ListenAndServe(addr, r)
+}
+
+// params returns the names of the {name} parameters in path.
+func params(path string) []string {
+	var names []string
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			names = append(names, segment[1:len(segment)-1])
+		}
+	}
+	return names
+}
+
+// colonPath rewrites the {name} parameters of path to the :name syntax of gin, echo and fiber.
+func colonPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = ":" + segment[1:len(segment)-1]
+		}
+	}
+	return strings.Join(segments, "/")
+}
+`
+		},
+		"app/router/routing/backend.go": func() string {
+			return `package routing
+
+import (
+	{{- if eq .BackendPkg "stdlib"}}
+	"net/http"
+	"strings"
+	{{- else if eq .BackendPkg "fiber"}}
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	{{- else if eq .BackendPkg "echo"}}
+	"errors"
+	"net/http"
+
+	"{{.BackendImport}}"
+	{{- else}}
+	"net/http"
+
+	"{{.BackendImport}}"
+	{{- end}}
+)
+{{- if eq .BackendPkg "chi"}}
+
+// New returns a Router on chi.
+func New() Router {
+	return &router{backend: &chiBackend{mux: chi.NewRouter()}}
+}
+
+type chiBackend struct {
+	mux *chi.Mux
+}
+
+func (b *chiBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mux.ServeHTTP(w, r)
+}
+
+func (b *chiBackend) handle(method, path string, params []string, handler http.Handler) {
+	b.mux.Method(method, path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, name := range params {
+			r.SetPathValue(name, chi.URLParam(r, name))
+		}
+		handler.ServeHTTP(w, r)
+	}))
+}
+
+func (b *chiBackend) notFound(handler http.Handler) {
+	b.mux.NotFound(handler.ServeHTTP)
+}
+
+func (b *chiBackend) mount(prefix string, handler http.Handler) {
+	b.mux.Mount(prefix, handler)
+}
+{{- else if eq .BackendPkg "gin"}}
+
+// New returns a Router on gin. Paths are matched as they are, without redirecting
+// to the path with or without a trailing slash.
+func New() Router {
+	engine := gin.New()
+	engine.RedirectTrailingSlash = false
+	engine.HandleMethodNotAllowed = true
+	return &router{backend: &ginBackend{engine: engine}}
+}
+
+type ginBackend struct {
+	engine *gin.Engine
+}
+
+func (b *ginBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.engine.ServeHTTP(w, r)
+}
+
+func (b *ginBackend) handle(method, path string, params []string, handler http.Handler) {
+	b.engine.Handle(method, colonPath(path), func(c *gin.Context) {
+		for _, name := range params {
+			c.Request.SetPathValue(name, c.Param(name))
+		}
+		handler.ServeHTTP(c.Writer, c.Request)
+	})
+}
+
+func (b *ginBackend) notFound(handler http.Handler) {
+	b.engine.NoRoute(gin.WrapH(handler))
+}
+
+func (b *ginBackend) mount(prefix string, handler http.Handler) {
+	b.engine.Any(prefix, gin.WrapH(handler))
+	b.engine.Any(prefix+"/*path", gin.WrapH(handler))
+}
+{{- else if eq .BackendPkg "echo"}}
+
+// New returns a Router on echo.
+func New() Router {
+	b := &echoBackend{echo: echo.New()}
+	b.fallback = b.echo.HTTPErrorHandler
+	b.echo.HTTPErrorHandler = b.handleError
+	return &router{backend: b}
+}
+
+type echoBackend struct {
+	echo     *echo.Echo
+	missing  http.Handler
+	fallback echo.HTTPErrorHandler
+}
+
+func (b *echoBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.echo.ServeHTTP(w, r)
+}
+
+func (b *echoBackend) handle(method, path string, params []string, handler http.Handler) {
+	b.echo.Add(method, colonPath(path), func(c echo.Context) error {
+		r := c.Request()
+		for _, name := range params {
+			r.SetPathValue(name, c.PathParam(name))
+		}
+		handler.ServeHTTP(c.Response(), r)
+		return nil
+	})
+}
+
+func (b *echoBackend) notFound(handler http.Handler) {
+	b.missing = handler
+}
+
+// handleError runs the not found handler for the requests no route matched. A catch-all
+// route would also take the paths with routes for other methods, which get 405 instead.
+func (b *echoBackend) handleError(c echo.Context, err error) {
+	var e *echo.HTTPError
+	if b.missing != nil && errors.As(err, &e) && e.Code == http.StatusNotFound && !c.Response().Committed {
+		b.missing.ServeHTTP(c.Response(), c.Request())
+		return
+	}
+	b.fallback(c, err)
+}
+
+func (b *echoBackend) mount(prefix string, handler http.Handler) {
+	b.echo.Any(prefix, echo.WrapHandler(handler))
+	b.echo.Any(prefix+"/*", echo.WrapHandler(handler))
+}
+{{- else if eq .BackendPkg "fiber"}}
+
+// New returns a Router on fiber. Paths are matched as they are: case sensitive and
+// without ignoring a trailing slash, like on the other backends.
+func New() Router {
+	b := &fiberBackend{}
+	b.app = fiber.New(fiber.Config{
+		CaseSensitive:         true,
+		StrictRouting:         true,
+		DisableStartupMessage: true,
+		ErrorHandler:          b.handleError,
+	})
+	return &router{backend: b}
+}
+
+type fiberBackend struct {
+	app     *fiber.App
+	missing http.Handler
+}
+
+// ServeHTTP runs a request through fiber, Listen serves it with fasthttp instead.
+func (b *fiberBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	adaptor.FiberApp(b.app)(w, r)
+}
+
+func (b *fiberBackend) Listen(addr string) error {
+	return b.app.Listen(addr)
+}
+
+func (b *fiberBackend) handle(method, path string, params []string, handler http.Handler) {
+	b.app.Add(method, colonPath(path), func(c *fiber.Ctx) error {
+		// fiber reuses the memory of its parameters once the handler returns, they are copied.
+		values := make([]string, len(params))
+		for i, name := range params {
+			values[i] = strings.Clone(c.Params(name))
+		}
+		return adaptor.HTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for i, name := range params {
+				r.SetPathValue(name, values[i])
+			}
+			handler.ServeHTTP(w, r)
+		})(c)
+	})
+}
+
+func (b *fiberBackend) notFound(handler http.Handler) {
+	b.missing = handler
+}
+
+func (b *fiberBackend) mount(prefix string, handler http.Handler) {
+	b.app.Use(prefix, adaptor.HTTPHandler(handler))
+}
+
+// handleError runs the not found handler for the requests no route matched.
+func (b *fiberBackend) handleError(c *fiber.Ctx, err error) error {
+	var e *fiber.Error
+	if b.missing != nil && errors.As(err, &e) && e.Code == fiber.StatusNotFound {
+		return adaptor.HTTPHandler(b.missing)(c)
+	}
+	return fiber.DefaultErrorHandler(c, err)
+}
+{{- else}}
+
+// New returns a Router on http.ServeMux.
+func New() Router {
+	return &router{backend: &stdlibBackend{mux: http.NewServeMux(), methods: make(map[string]bool)}}
+}
+
+// stdlibBackend registers a "METHOD /path" pattern per route, so ServeMux answers 405 Method Not Allowed
+// with an Allow header itself when a path only has routes for other methods.
+type stdlibBackend struct {
+	mux *http.ServeMux
+	// methods are the methods routes were registered for.
+	methods map[string]bool
+	missing http.Handler
+}
+
+func (b *stdlibBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if b.missing != nil && !b.matches(r) {
+		b.missing.ServeHTTP(w, r)
+		return
+	}
+	b.mux.ServeHTTP(w, r)
+}
+
+// matches reports whether a pattern matches the path of r, with the method of r or any other one.
+func (b *stdlibBackend) matches(r *http.Request) bool {
+	if _, pattern := b.mux.Handler(r); pattern != "" {
+		return true
+	}
+	for method := range b.methods {
+		probe := *r
+		probe.Method = method
+		if _, pattern := b.mux.Handler(&probe); pattern != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// handle registers handler for method and path. A path ending in a slash only matches itself,
+// like it does on the other backends, instead of every path below it.
+func (b *stdlibBackend) handle(method, path string, params []string, handler http.Handler) {
+	if strings.HasSuffix(path, "/") {
+		path += "{$}"
+	}
+	b.methods[method] = true
+	b.mux.Handle(method+" "+path, handler)
+}
+
+func (b *stdlibBackend) notFound(handler http.Handler) {
+	b.missing = handler
+}
+
+func (b *stdlibBackend) mount(prefix string, handler http.Handler) {
+	b.mux.Handle(prefix, handler)
+	b.mux.Handle(prefix+"/", handler)
+}
+{{- end}}
+`
+		},
+		"app/router/routing/routing_test.go": func() string {
+			return `package routing
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// trace is a middleware adding name to the X-Trace header of the response, to check which middlewares ran and in which order.
+func trace(name string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("X-Trace", name)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// reply is a handler writing status and body, with the path parameters in params filled in.
+func reply(status int, body string, params ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		values := make([]any, len(params))
+		for i, name := range params {
+			values[i] = r.PathValue(name)
+		}
+		w.WriteHeader(status)
+		fmt.Fprintf(w, body, values...)
+	}
+}
+
+// routeTable is the route table every backend has to serve the same way.
+func routeTable() Router {
+	r := New()
+	r.Use(trace("a"), trace("b"))
+	r.NotFound(reply(http.StatusNotFound, "not found"))
+
+	r.Get("/", reply(http.StatusOK, "home"))
+	r.Get("/posts", reply(http.StatusOK, "index"))
+	r.Post("/posts", reply(http.StatusCreated, "create"))
+	r.Get("/posts/{id}", reply(http.StatusOK, "show %s", "id"))
+	r.Put("/posts/{id}", reply(http.StatusOK, "replace %s", "id"))
+	r.Patch("/posts/{id}", reply(http.StatusOK, "update %s", "id"))
+	r.Delete("/posts/{id}", reply(http.StatusNoContent, ""))
+	r.Get("/users/{user}/posts/{post}", reply(http.StatusOK, "post %s of %s", "post", "user"))
+
+	r.Group("/admin", func(r Router) {
+		r.Use(trace("admin"))
+		r.Get("/stats", reply(http.StatusOK, "stats"))
+		r.Group("/users", func(r Router) {
+			r.Get("/{id}", reply(http.StatusOK, "admin user %s", "id"))
+		})
+	})
+	r.Get("/after", reply(http.StatusOK, "after"))
+
+	r.Static("/assets", http.FS(fstest.MapFS{"app.css": {Data: []byte("body{}")}}))
+	r.Mount("/legacy", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "legacy %s %s", r.Method, r.URL.Path)
+	}))
+	return r
+}
+
+func TestConformance(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		status int
+		body   string
+		trace  string
+	}{
+		{http.MethodGet, "/", http.StatusOK, "home", "a,b"},
+		{http.MethodGet, "/posts", http.StatusOK, "index", "a,b"},
+		{http.MethodPost, "/posts", http.StatusCreated, "create", "a,b"},
+		{http.MethodGet, "/posts/42", http.StatusOK, "show 42", "a,b"},
+		{http.MethodPut, "/posts/42", http.StatusOK, "replace 42", "a,b"},
+		{http.MethodPatch, "/posts/42", http.StatusOK, "update 42", "a,b"},
+		{http.MethodDelete, "/posts/42", http.StatusNoContent, "", "a,b"},
+		{http.MethodGet, "/users/7/posts/42", http.StatusOK, "post 42 of 7", "a,b"},
+		{http.MethodGet, "/admin/stats", http.StatusOK, "stats", "a,b,admin"},
+		{http.MethodGet, "/admin/users/7", http.StatusOK, "admin user 7", "a,b,admin"},
+		{http.MethodGet, "/after", http.StatusOK, "after", "a,b"},
+		{http.MethodGet, "/assets/app.css", http.StatusOK, "body{}", "a,b"},
+		{http.MethodGet, "/legacy/old/page", http.StatusOK, "legacy GET /old/page", "a,b"},
+		{http.MethodPost, "/legacy/form", http.StatusOK, "legacy POST /form", "a,b"},
+
+		// Registered paths with another method.
+		{http.MethodPost, "/posts/42", http.StatusMethodNotAllowed, "", ""},
+		{http.MethodDelete, "/posts", http.StatusMethodNotAllowed, "", ""},
+
+		// Paths no route matches.
+		{http.MethodGet, "/missing", http.StatusNotFound, "not found", "a,b"},
+		{http.MethodGet, "/Posts", http.StatusNotFound, "not found", "a,b"},
+		{http.MethodGet, "/stats", http.StatusNotFound, "not found", "a,b"},
+		{http.MethodGet, "/users/7/posts", http.StatusNotFound, "not found", "a,b"},
+	}
+
+	router := routeTable()
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+			res := rec.Result()
+			body, _ := io.ReadAll(res.Body)
+
+			if res.StatusCode != tt.status {
+				t.Fatalf("got status %d, want %d", res.StatusCode, tt.status)
+			}
+			// The body of a 405 response is up to the backend.
+			if tt.status != http.StatusMethodNotAllowed && string(body) != tt.body {
+				t.Errorf("got body %q, want %q", body, tt.body)
+			}
+			if trace := strings.Join(res.Header.Values("X-Trace"), ","); trace != tt.trace {
+				t.Errorf("got middlewares %q, want %q", trace, tt.trace)
+			}
+		})
+	}
+}
+
+func TestGroupMiddlewaresStayInGroup(t *testing.T) {
+	r := New()
+	r.Group("/api", func(r Router) {
+		r.Use(trace("api"))
+		r.Get("/ping", reply(http.StatusOK, "pong"))
+	})
+	r.Get("/ping", reply(http.StatusOK, "pong"))
+
+	for path, want := range map[string]string{"/api/ping": "api", "/ping": ""} {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if trace := strings.Join(rec.Result().Header.Values("X-Trace"), ","); trace != want {
+			t.Errorf("%s: got middlewares %q, want %q", path, trace, want)
+		}
+	}
+}
+
+func TestParams(t *testing.T) {
+	if got := strings.Join(params("/users/{user}/posts/{post}"), ","); got != "user,post" {
+		t.Errorf("got %q, want %q", got, "user,post")
+	}
+	if got := colonPath("/users/{user}/posts/{post}"); got != "/users/:user/posts/:post" {
+		t.Errorf("got %q, want %q", got, "/users/:user/posts/:post")
+	}
+}
 `
 		},
 	}
@@ -103,7 +638,8 @@ func (g *GenRouterPlugin) Version() string {
 }
 
 func (g *GenRouterPlugin) Dependencies() []string {
-	return []string{}
+	// app/router imports app/handlers and app/middleware.
+	return []string{"GenHandlersPlugin", "GenMiddlewarePlugin"}
 }
 
 func (g *GenRouterPlugin) AuthorName() string {
@@ -123,7 +659,11 @@ func (g *GenRouterPlugin) GitHub() string {
 }
 
 func (g *GenRouterPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenRouterPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenRouterPlugin(data config.ProjectData) *GenRouterPlugin {
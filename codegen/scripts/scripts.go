@@ -74,7 +74,11 @@ func (g *GenScriptsPlugin) GitHub() string {
 }
 
 func (g *GenScriptsPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenScriptsPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenScriptsPlugin(data config.ProjectData) *GenScriptsPlugin {
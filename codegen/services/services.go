@@ -101,7 +101,11 @@ func (g *GenServicesPlugin) GitHub() string {
 }
 
 func (g *GenServicesPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenServicesPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenServicesPlugin(data config.ProjectData) *GenServicesPlugin {
@@ -14,21 +14,15 @@ func (g *GenTypesPlugin) Init() error {
 	// Initialize Files
 	g.Files = map[string]func() string{
 		"app/types/gost/gost.go": func() string {
-			return `package core
+			return `package gost
 
 import (
-	"{{.AppName}}/app/types/core"
+	"{{.AppName}}/app/router/routing"
 	"encoding/json"
+	"log"
+	"net/http"
+	"os"
 	"reflect"
-    {{- if eq .BackendPkg "echo"}}
-    "github.com/labstack/echo/v5"
-    {{- else if eq .BackendPkg "gin"}}
-    "github.com/gin-gonic/gin"
-    {{- else if eq .BackendPkg "chi"}}
-    "github.com/go-chi/chi/v5"
-    {{- else if eq .BackendPkg "stdlib"}}
-    "net/http"
-    {{- end }}
 
     "github.com/a-h/templ"
     "github.com/gorilla/sessions"
@@ -50,13 +44,13 @@ type Configurable interface {
 type Route struct {
 	Handler     interface{}
 	Method      string
-	Middlewares []interface{}
+	Middlewares []routing.Middleware
 	Path        string
 }
 
 // RouteGroup ->    A collection of routes prefixed with certain prefix.
 type RouteGroup struct {
-	Middlewares []interface{}
+	Middlewares []routing.Middleware
 	Prefix      string
 	Routes      []Route
 }
@@ -64,60 +58,23 @@ type RouteGroup struct {
 // ResourceRoutes -> Define the Routes associated with a resource.
 type ResourceRoutes struct {
 	Controller  interface{}
-	Middlewares []interface{}
+	Middlewares []routing.Middleware
 	Resource    string
 }
 
 func New() *Gost {
-    return &Gost{
-        {{- if eq .BackendPkg "echo"}}
-        router: echo.New(),
-        {{- else if eq .BackendPkg "gin"}}
-        router: gin.Default(),
-        {{- else if eq .BackendPkg "chi"}}
-        router: chi.NewRouter(),
-        {{- else if eq .BackendPkg "stdlib"}}
-        router: http.NewServeMux(),
-        {{- end }}
-    }
-}
-
-{{- if eq .BackendPkg "echo"}}
-func (g *Gost) AddResource(resource string, controller interface{}, middlewares ...echo.MiddlewareFunc) {
-    g.resourceRoutes = append(g.resourceRoutes, ResourceRoutes{
-        Resource:    resource,
-        Controller:  controller,
-        Middlewares: middlewares,
-    })
-    
-    g.registerResourceRoutes(resource, controller, middlewares...)
+    return &Gost{router: routing.New()}
 }
 
-func (g *Gost) registerResourceRoutes(resource string, controller interface{}, middlewares ...echo.MiddlewareFunc) {
-    basePath := "/" + resource
-    g.router.GET(basePath, wrapHandler(controller, "Index", middlewares...))
-    g.router.GET(basePath+"/:id", wrapHandler(controller, "Show", middlewares...))
-    g.router.POST(basePath, wrapHandler(controller, "Create", middlewares...))
-    g.router.PUT(basePath+"/:id", wrapHandler(controller, "Update", middlewares...))
-    g.router.DELETE(basePath+"/:id", wrapHandler(controller, "Delete", middlewares...))
+// Router returns the router the resources of g are registered on.
+func (g *Gost) Router() routing.Router {
+    return g.router
 }
 
-func wrapHandler(controller interface{}, methodName string, middlewares ...echo.MiddlewareFunc) echo.HandlerFunc {
-    return func(c echo.Context) error {
-        method := reflect.ValueOf(controller).MethodByName(methodName)
-        if !method.IsValid() {
-            return echo.NewHTTPError(http.StatusNotFound, "method not found")
-        }
-        args := []reflect.Value{reflect.ValueOf(c)}
-        results := method.Call(args)
-        if len(results) == 2 && results[1].Interface() != nil {
-            return results[1].Interface().(error)
-        }
-        return results[0].Interface().(error)
-    }
-}
-{{- else if eq .BackendPkg "gin"}}
-func (g *Gost) AddResource(resource string, controller interface{}, middlewares ...gin.HandlerFunc) {
+// AddResource registers the Index, Show, Create, Update and Delete actions of controller under /resource,
+// Show, Update and Delete read the id with Param("id"). Actions are methods of controller taking a *Gost
+// and returning an error, the ones controller doesn't have are left out.
+func (g *Gost) AddResource(resource string, controller interface{}, middlewares ...routing.Middleware) {
     g.resourceRoutes = append(g.resourceRoutes, ResourceRoutes{
         Resource:    resource,
         Controller:  controller,
@@ -127,109 +84,44 @@ func (g *Gost) AddResource(resource string, controller interface{}, middlewares
     g.registerResourceRoutes(resource, controller, middlewares...)
 }
 
-func (g *Gost) registerResourceRoutes(resource string, controller interface{}, middlewares ...gin.HandlerFunc) {
-    basePath := "/" + resource
-    g.router.GET(basePath, wrapHandler(controller, "Index", middlewares...))
-    g.router.GET(basePath+"/:id", wrapHandler(controller, "Show", middlewares...))
-    g.router.POST(basePath, wrapHandler(controller, "Create", middlewares...))
-    g.router.PUT(basePath+"/:id", wrapHandler(controller, "Update", middlewares...))
-    g.router.DELETE(basePath+"/:id", wrapHandler(controller, "Delete", middlewares...))
-}
-
-func wrapHandler(controller interface{}, methodName string, middlewares ...gin.HandlerFunc) gin.HandlerFunc {
-    return func(c *gin.Context) {
-        method := reflect.ValueOf(controller).MethodByName(methodName)
-        if !method.IsValid() {
-            c.JSON(http.StatusNotFound, gin.H{"error": "method not found"})
-            return
+func (g *Gost) registerResourceRoutes(resource string, controller interface{}, middlewares ...routing.Middleware) {
+    g.router.Group("/"+resource, func(r routing.Router) {
+        r.Use(middlewares...)
+        if handler, ok := wrapHandler(controller, "Index"); ok {
+            r.Get("", handler)
         }
-        args := []reflect.Value{reflect.ValueOf(c)}
-        results := method.Call(args)
-        if len(results) == 2 && results[1].Interface() != nil {
-            c.JSON(http.StatusInternalServerError, gin.H{"error": results[1].Interface().(error).Error()})
-            return
+        if handler, ok := wrapHandler(controller, "Show"); ok {
+            r.Get("/{id}", handler)
         }
-        c.JSON(http.StatusOK, results[0].Interface())
-    }
-}
-{{- else if eq .BackendPkg "chi"}}
-func (g *Gost) AddResource(resource string, controller interface{}, middlewares ...func(http.Handler) http.Handler) {
-    g.resourceRoutes = append(g.resourceRoutes, ResourceRoutes{
-        Resource:    resource,
-        Controller:  controller,
-        Middlewares: middlewares,
-    })
-
-    g.registerResourceRoutes(resource, controller, middlewares...)
-}
-
-func (g *Gost) registerResourceRoutes(resource string, controller interface{}, middlewares ...func(http.Handler) http.Handler) {
-    basePath := "/" + resource
-    g.router.Method("GET", basePath, wrapHandler(controller, "Index", middlewares...))
-    g.router.Method("GET", basePath+"/:id", wrapHandler(controller, "Show", middlewares...))
-    g.router.Method("POST", basePath, wrapHandler(controller, "Create", middlewares...))
-    g.router.Method("PUT", basePath+"/:id", wrapHandler(controller, "Update", middlewares...))
-    g.router.Method("DELETE", basePath+"/:id", wrapHandler(controller, "Delete", middlewares...))
-}
-
-func wrapHandler(controller interface{}, methodName string, middlewares ...func(http.Handler) http.Handler) http.HandlerFunc {
-    return func(w http.ResponseWriter, r *http.Request) {
-        method := reflect.ValueOf(controller).MethodByName(methodName)
-        if !method.IsValid() {
-            http.Error(w, "method not found", http.StatusNotFound)
-            return
+        if handler, ok := wrapHandler(controller, "Create"); ok {
+            r.Post("", handler)
         }
-        args := []reflect.Value{reflect.ValueOf(r)}
-        results := method.Call(args)
-        if len(results) == 2 && results[1].Interface() != nil {
-            http.Error(w, results[1].Interface().(error).Error(), http.StatusInternalServerError)
-            return
+        if handler, ok := wrapHandler(controller, "Update"); ok {
+            r.Put("/{id}", handler)
+        }
+        if handler, ok := wrapHandler(controller, "Delete"); ok {
+            r.Delete("/{id}", handler)
         }
-        // Write the response
-        w.WriteHeader(http.StatusOK)
-        w.Write([]byte(results[0].Interface().(string)))
-    }
-}
-{{- else if eq .BackendPkg "stdlib"}}
-func (g *Gost) AddResource(resource string, controller interface{}, middlewares ...func(http.Handler) http.Handler) {
-    g.resourceRoutes = append(g.resourceRoutes, ResourceRoutes{
-        Resource:    resource,
-        Controller:  controller,
-        Middlewares: middlewares,
     })
-
-    g.registerResourceRoutes(resource, controller, middlewares...)
 }
 
-func (g *Gost) registerResourceRoutes(resource string, controller interface{}, middlewares ...func(http.Handler) http.Handler) {
-    basePath := "/" + resource
-    g.router.Handle(basePath, wrapHandler(controller, "Index", middlewares...))
-    g.router.Handle(basePath+"/:id", wrapHandler(controller, "Show", middlewares...))
-    g.router.Handle(basePath, wrapHandler(controller, "Create", middlewares...))
-    g.router.Handle(basePath+"/:id", wrapHandler(controller, "Update", middlewares...))
-    g.router.Handle(basePath+"/:id", wrapHandler(controller, "Delete", middlewares...))
-}
-
-func wrapHandler(controller interface{}, methodName string, middlewares ...func(http.Handler) http.Handler) http.HandlerFunc {
+// wrapHandler returns a handler calling the methodName action of controller with a Gost for the request,
+// false when controller has no such action.
+func wrapHandler(controller interface{}, methodName string) (http.HandlerFunc, bool) {
+    method := reflect.ValueOf(controller).MethodByName(methodName)
+    if !method.IsValid() {
+        return nil, false
+    }
+    action, ok := method.Interface().(func(*Gost) error)
+    if !ok {
+        return nil, false
+    }
     return func(w http.ResponseWriter, r *http.Request) {
-        method := reflect.ValueOf(controller).MethodByName(methodName)
-        if !method.IsValid() {
-            http.Error(w, "method not found", http.StatusNotFound)
-            return
-        }
-        args := []reflect.Value{reflect.ValueOf(r)}
-        results := method.Call(args)
-        if len(results) == 2 && results[1].Interface() != nil {
-            http.Error(w, results[1].Interface().(error).Error(), http.StatusInternalServerError)
-            return
+        if err := action(&Gost{request: r, response: w}); err != nil {
+            http.Error(w, err.Error(), http.StatusInternalServerError)
         }
-        // Write the response
-        w.WriteHeader(http.StatusOK)
-        w.Write([]byte(results[0].Interface().(string)))
-    }
+    }, true
 }
-{{- end }}
-
 
 var store *sessions.CookieStore
 
@@ -249,8 +141,8 @@ func (DefaultAuth) Check() bool { return false }
 type Gost struct {
 	routeGroups    []RouteGroup
 	resourceRoutes []ResourceRoutes
-	eventHooks     []func(evt *core.ServeEvent) error
-	request        http.Request
+	router         routing.Router
+	request        *http.Request
 	response       http.ResponseWriter
 }
 
@@ -272,10 +164,15 @@ func (g *Gost) Redirect(status int, url string) error {
         g.response.WriteHeader(http.StatusSeeOther)
         return nil
     }
-    http.Redirect(g.response, &g.request, url, status)
+    http.Redirect(g.response, g.request, url, status)
     return nil
 }
 
+// Param returns the value of the {name} parameter in the path of the route.
+func (g *Gost) Param(name string) string {
+    return g.request.PathValue(name)
+}
+
 func (g *Gost) FormValue(name string) string {
 	return g.request.PostFormValue(name)
 }
@@ -337,235 +234,6 @@ func init() {
     }
     store = sessions.NewCookieStore([]byte(appSecret))
 }
-
-// Router interface to abstract the underlying server implementation
-type Router interface {
-    Use(middleware ...interface{})
-    Get(path string, handler HandlerFunc)
-    Post(path string, handler HandlerFunc)
-	Put(path string, handler HandlerFunc)
-	Delete(path string, handler HandlerFunc)
-	Patch(path string, handler HandlerFunc)
-    NotFound(handler HandlerFunc)
-}
-
-{{if eq .BackendPkg "chi"}}
-type chiRouter struct {
-    router *chi.Mux
-}
-
-func (c *chiRouter) Use(middleware ...interface{}) {
-    for _, m := range middleware {
-        c.router.Use(m.(func(http.Handler) http.Handler))
-    }
-}
-
-func (c *chiRouter) Get(path string, handler HandlerFunc) {
-    c.router.Get(path, func(w http.ResponseWriter, r *http.Request) {
-        g := &Gost{Response: w, Request: r, Router: c}
-        if err := handler(g); err != nil {
-            errorHandler(g, err)
-        }
-    })
-}
-
-func (c *chiRouter) Post(path string, handler HandlerFunc) {
-    c.router.Post(path, func(w http.ResponseWriter, r *http.Request) {
-        g := &Gost{Response: w, Request: r, Router: c}
-        if err := handler(g); err != nil {
-            errorHandler(g, err)
-        }
-    })
-}
-
-func (g *ginRouter) Put(path string, handler HandlerFunc) {
-	g.router.Put(path, func(c *gin.Context) {
-		gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-		if err := handler(gost); err != nil {
-			errorHandler(gost, err)
-		}
-	})
-}
-
-func (g *ginRouter) Patch(path string, handler HandlerFunc) {
-	g.router.Patch(path, func(c *gin.Context) {
-		gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-		if err := handler(gost); err != nil {
-			errorHandler(gost, err)
-		}
-	})
-}
-
-func (g *ginRouter) Delete(path string, handler HandlerFunc) {
-	g.router.Delete(path, func(c *gin.Context) {
-		gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-		if err := handler(gost); err != nil {
-			errorHandler(gost, err)
-		}
-	})
-}
-
-func (c *chiRouter) NotFound(handler HandlerFunc) {
-    c.router.NotFound(func(w http.ResponseWriter, r *http.Request) {
-        g := &Gost{Response: w, Request: r, Router: c}
-        if err := handler(g); err != nil {
-            errorHandler(g, err)
-        }
-    })
-}
-
-
-func NewRouter() Router {
-	return &chiRouter{router: chi.NewRouter()}
-}
-
-{{end}}
-
-{{if eq .BackendPkg "echo"}}
-type echoRouter struct {
-    router *echo.Echo
-}
-
-func (e *echoRouter) Use(middleware ...interface{}) {
-    for _, m := range middleware {
-        e.router.Use(m.(echo.MiddlewareFunc))
-    }
-}
-
-func (e *echoRouter) Get(path string, handler HandlerFunc) {
-    e.router.GET(path, func(c echo.Context) error {
-        g := &Gost{Response: c.Response(), Request: c.Request(), Router: e}
-        if err := handler(g); err != nil {
-            errorHandler(g, err)
-        }
-        return nil
-    })
-}
-
-func (e *echoRouter) Post(path string, handler HandlerFunc) {
-    e.router.POST(path, func(c echo.Context) error {
-        g := &Gost{Response: c.Response(), Request: c.Request(), Router: e}
-        if err := handler(g); err != nil {
-            errorHandler(g, err)
-        }
-        return nil
-    })
-}
-
-func (g *ginRouter) Put(path string, handler HandlerFunc) {
-	g.router.PUT(path, func(c *gin.Context) {
-		gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-		if err := handler(gost); err != nil {
-			errorHandler(gost, err)
-		}
-	})
-}
-
-func (g *ginRouter) Patch(path string, handler HandlerFunc) {
-	g.router.PATCH(path, func(c *gin.Context) {
-		gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-		if err := handler(gost); err != nil {
-			errorHandler(gost, err)
-		}
-	})
-}
-
-func (g *ginRouter) Delete(path string, handler HandlerFunc) {
-	g.router.DELETE(path, func(c *gin.Context) {
-		gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-		if err := handler(gost); err != nil {
-			errorHandler(gost, err)
-		}
-	})
-}
-
-func (e *echoRouter) NotFound(handler HandlerFunc) {
-    e.router.HTTPErrorHandler = func(err error, c echo.Context) {
-        if c.Response().Committed {
-            return
-        }
-        g := &Gost{Response: c.Response(), Request: c.Request(), Router: e}
-        if err := handler(g); err != nil {
-            errorHandler(g, err)
-        }
-    }
-}
-
-func NewRouter() Router {
-	return return &echoRouter{router: echo.New()}
-}
-{{end}}
-
-{{if eq .BackendPkg "gin"}}
-type ginRouter struct {
-    router *gin.Engine
-}
-
-func (g *ginRouter) Use(middleware ...interface{}) {
-    for _, m := range middleware {
-        g.router.Use(m.(gin.HandlerFunc))
-    }
-}
-
-func (g *ginRouter) Get(path string, handler HandlerFunc) {
-    g.router.GET(path, func(c *gin.Context) {
-        gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-        if err := handler(gost); err != nil {
-            errorHandler(gost, err)
-        }
-    })
-}
-
-func (g *ginRouter) Post(path string, handler HandlerFunc) {
-    g.router.POST(path, func(c *gin.Context) {
-        gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-        if err := handler(gost); err != nil {
-            errorHandler(gost, err)
-        }
-    })
-}
-
-func (g *ginRouter) Put(path string, handler HandlerFunc) {
-	g.router.PUT(path, func(c *gin.Context) {
-		gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-		if err := handler(gost); err != nil {
-			errorHandler(gost, err)
-		}
-	})
-}
-
-func (g *ginRouter) Patch(path string, handler HandlerFunc) {
-	g.router.PATCH(path, func(c *gin.Context) {
-		gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-		if err := handler(gost); err != nil {
-			errorHandler(gost, err)
-		}
-	})
-}
-
-func (g *ginRouter) Delete(path string, handler HandlerFunc) {
-	g.router.DELETE(path, func(c *gin.Context) {
-		gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-		if err := handler(gost); err != nil {
-			errorHandler(gost, err)
-		}
-	})
-}
-
-func (g *ginRouter) NotFound(handler HandlerFunc) {
-    g.router.NoRoute(func(c *gin.Context) {
-        gost := &Gost{Response: c.Writer, Request: c.Request, Router: g}
-        if err := handler(gost); err != nil {
-            errorHandler(gost, err)
-        }
-    })
-}
-
-func NewRouter() Router {
-	return &ginRouter{router: gin.Default()}
-}
-
-{{end}}
 `
 		},
 		"app/types/mailer/mailer.go": func() string {
@@ -579,6 +247,8 @@ func NewRouter() Router {
                 "github.com/gin-gonic/gin"
                 {{- else if eq .BackendPkg "chi"}}
                 "github.com/go-chi/chi/v5"
+                {{- else if eq .BackendPkg "fiber"}}
+                "github.com/gofiber/fiber/v2"
                 {{- else if eq .BackendPkg "stdlib"}}
                 "net/http"
                 {{- end }}
@@ -601,6 +271,11 @@ func NewRouter() Router {
                 SendRecordResetPasswordMail(r chi.Router, email string, data interface{}) error
                 SendRecordVerificationMail(r chi.Router, email string, data interface{}) error
                 SendRecordChangeEmailMail(r chi.Router, email string, data interface{}) error
+                {{- else if eq .BackendPkg "fiber"}}
+                SendAdminResetPasswordMail(ctx *fiber.Ctx, email string, data interface{}) error
+                SendRecordResetPasswordMail(ctx *fiber.Ctx, email string, data interface{}) error
+                SendRecordVerificationMail(ctx *fiber.Ctx, email string, data interface{}) error
+                SendRecordChangeEmailMail(ctx *fiber.Ctx, email string, data interface{}) error
                 {{- else if eq .BackendPkg "stdlib"}}
                 SendAdminResetPasswordMail(r *http.Request, email string, data interface{}) error
                 SendRecordResetPasswordMail(r *http.Request, email string, data interface{}) error
@@ -680,6 +355,26 @@ func NewRouter() Router {
                 // Implementation for sending record change email mail using Chi router
                 return nil
             }
+            {{- else if eq .BackendPkg "fiber"}}
+            func (mc *MailClient) SendAdminResetPasswordMail(ctx *fiber.Ctx, email string, data interface{}) error {
+                // Implementation for sending admin reset password email using Fiber context
+                return nil
+            }
+            
+            func (mc *MailClient) SendRecordResetPasswordMail(ctx *fiber.Ctx, email string, data interface{}) error {
+                // Implementation for sending record reset password email using Fiber context
+                return nil
+            }
+            
+            func (mc *MailClient) SendRecordVerificationMail(ctx *fiber.Ctx, email string, data interface{}) error {
+                // Implementation for sending record verification email using Fiber context
+                return nil
+            }
+            
+            func (mc *MailClient) SendRecordChangeEmailMail(ctx *fiber.Ctx, email string, data interface{}) error {
+                // Implementation for sending record change email mail using Fiber context
+                return nil
+            }
             {{- else if eq .BackendPkg "stdlib"}}
             func (mc *MailClient) SendAdminResetPasswordMail(r *http.Request, email string, data interface{}) error {
                 // Implementation for sending admin reset password email using stdlib http request
@@ -772,6 +467,8 @@ import (
     "github.com/gin-gonic/gin"
     {{- else if eq .BackendPkg "chi"}}
     "github.com/go-chi/chi/v5"
+    {{- else if eq .BackendPkg "fiber"}}
+    "github.com/gofiber/fiber/v2"
     {{- else if eq .BackendPkg "stdlib"}}
     "net/http"
     {{- end }}
@@ -1130,7 +827,7 @@ type Event interface {
 	OnRecordAfterUpdateRequest(tags []string, handler func(evt *RecordUpdateEvent) error) Event
 	OnRecordBeforeDeleteRequest(tags []string, handler func(evt *RecordDeleteEvent) error) Event
 	OnRecordAfterDeleteRequest(tags []string, handler func(evt *RecordDeleteEvent) error) Event
-	{{- else if eq .BackendPkg "stdlib"}}
+	{{- else if or (eq .BackendPkg "stdlib") (eq .BackendPkg "fiber")}}
 	OnBeforeBootstrap(handler func(evt *BootstrapEvent) error) Event
 	OnAfterBootstrap(handler func(evt *BootstrapEvent) error) Event
 	OnBeforeServe(handler func(evt *ServeEvent) error) Event
@@ -1225,6 +922,8 @@ import (
 	"github.com/gin-gonic/gin"
 	{{- else if eq .BackendPkg "chi"}}
 	"github.com/go-chi/chi/v5"
+	{{- else if eq .BackendPkg "fiber"}}
+	"github.com/gofiber/fiber/v2"
 	{{- else if eq .BackendPkg "stdlib"}}
 	"net/http"
 	{{- end }}
@@ -1362,6 +1061,8 @@ type ServeEvent struct {
     Router *gin.Engine
     {{- else if eq .BackendPkg "chi"}}
     Router chi.Router
+    {{- else if eq .BackendPkg "fiber"}}
+    Router *fiber.App
     {{- else if eq .BackendPkg "stdlib"}}
     Router *http.ServeMux
     {{- end }}
@@ -1411,7 +1112,11 @@ func (g *GenTypesPlugin) GitHub() string {
 }
 
 func (g *GenTypesPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenTypesPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenTypesPlugin(data config.ProjectData) *GenTypesPlugin {
@@ -0,0 +1,116 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// checkPackages type-checks the package dirs in targets with go/packages, third-party packages included.
+// The generated files are overlaid on the project at root, or loaded from dir when there is no project yet.
+// ok is false when the go command can't load them, like when the dependencies of the module are not
+// downloaded: nothing is downloaded while generating.
+func checkPackages(dir, root, module string, targets []string) (issues []error, ok bool) {
+	loadDir, overlay, err := overlayOn(dir, root)
+	if err != nil {
+		return nil, false
+	}
+	patterns := make([]string, 0, len(targets))
+	for _, target := range targets {
+		patterns = append(patterns, "./"+target)
+	}
+	config := &packages.Config{
+		// Dependencies are type-checked from source too, so a generated package that does not compile
+		// reports type errors instead of the list errors of a missing dependency.
+		Mode:    packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedImports | packages.NeedDeps,
+		Dir:     loadDir,
+		Env:     append(os.Environ(), "GOFLAGS=-mod=readonly", "GOPROXY=off", "GOWORK=off"),
+		Overlay: overlay,
+	}
+	pkgs, err := packages.Load(config, patterns...)
+	if err != nil {
+		return nil, false
+	}
+	unavailable := false
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		for _, e := range pkg.Errors {
+			if e.Kind == packages.ListError || strings.HasPrefix(e.Msg, "could not import ") {
+				unavailable = true
+			}
+		}
+	})
+	if unavailable {
+		return nil, false
+	}
+
+	roots := []string{loadDir, dir, root}
+	for _, pkg := range pkgs {
+		for _, e := range pkg.Errors {
+			issues = append(issues, packageIssue(roots, e))
+		}
+		for _, file := range pkg.Syntax {
+			if main := mainFunc(file); main != nil && file.Name.Name != "main" {
+				position := pkg.Fset.Position(main.Pos())
+				issues = append(issues, &Issue{Path: relative(roots, position.Filename), Line: position.Line, Column: position.Column,
+					Message: fmt.Sprintf("func main is declared in package %s, it only runs in package main", file.Name.Name)})
+			}
+		}
+	}
+	return issues, true
+}
+
+// overlayOn returns the dir to load the packages from and, when root is a module, the generated
+// Go files of dir placed over it.
+func overlayOn(dir, root string) (string, map[string][]byte, error) {
+	if root == "" {
+		return dir, nil, nil
+	}
+	if _, err := os.Stat(filepath.Join(root, "go.mod")); err != nil {
+		return dir, nil, nil
+	}
+	overlay := make(map[string][]byte)
+	err := filepath.WalkDir(dir, func(file string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(file, ".go") {
+			return err
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, file)
+		target, err := filepath.Abs(filepath.Join(root, rel))
+		overlay[target] = content
+		return err
+	})
+	return root, overlay, err
+}
+
+// packageIssue turns an error reported by go/packages, positioned as file:line:col, into an Issue.
+func packageIssue(roots []string, e packages.Error) error {
+	if e.Pos == "" {
+		return errors.New(e.Msg)
+	}
+	parts := strings.Split(e.Pos, ":")
+	var position []int
+	for len(parts) > 1 && len(position) < 2 {
+		n, err := strconv.Atoi(parts[len(parts)-1])
+		if err != nil {
+			break
+		}
+		position = append([]int{n}, position...)
+		parts = parts[:len(parts)-1]
+	}
+	issue := &Issue{Path: relative(roots, strings.Join(parts, ":")), Message: e.Msg}
+	if len(position) > 0 {
+		issue.Line = position[0]
+	}
+	if len(position) > 1 {
+		issue.Column = position[1]
+	}
+	return issue
+}
@@ -0,0 +1,307 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/theHamdiz/gost/cleaner"
+)
+
+// Issue is a problem found in a generated Go file, at a path relative to the project root.
+type Issue struct {
+	Path    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (i *Issue) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", i.Path, i.Line, i.Column, i.Message)
+}
+
+// Format runs go/format on every Go file under dir. Files that do not parse are left as they are
+// and every syntax error is returned as an Issue, joined with errors.Join.
+func Format(dir string) error {
+	var issues []error
+	err := filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(file, ".go") {
+			return err
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		formatted, err := format.Source(content)
+		if err != nil {
+			issues = append(issues, syntaxIssues(dir, file, err)...)
+			return nil
+		}
+		if string(formatted) == string(content) {
+			return nil
+		}
+		return os.WriteFile(file, formatted, 0644)
+	})
+	if err != nil {
+		return err
+	}
+	return errors.Join(issues...)
+}
+
+func syntaxIssues(dir, file string, err error) []error {
+	rel, _ := filepath.Rel(dir, file)
+	rel = filepath.ToSlash(rel)
+	var list scanner.ErrorList
+	if !errors.As(err, &list) {
+		return []error{&Issue{Path: rel, Message: err.Error()}}
+	}
+	issues := make([]error, 0, len(list))
+	for _, e := range list {
+		issues = append(issues, &Issue{Path: rel, Line: e.Pos.Line, Column: e.Pos.Column, Message: e.Msg})
+	}
+	return issues
+}
+
+// TypeCheck type-checks the packages of module that have Go files generated into dir, read on top of
+// the project at root so generating into an existing project sees its other files. It uses go/packages
+// when the dependencies of the module are already downloaded. Otherwise only the standard library and the
+// module itself are checked, as third-party packages are not downloaded while generating: their imports
+// and their uses are accepted as they are. Problems are returned as Issues joined with errors.Join.
+func TypeCheck(dir, root, module string) error {
+	c := &checker{
+		module:    module,
+		fset:      token.NewFileSet(),
+		std:       importer.ForCompiler(token.NewFileSet(), "gc", nil),
+		dirs:      make(map[string]map[string]string),
+		packages:  make(map[string]*types.Package),
+		issues:    make(map[string][]error),
+		unchecked: make(map[string]bool),
+		roots:     []string{dir, root},
+	}
+	if root != "" {
+		if err := c.collect(root); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	targets := make(map[string]bool)
+	if err := c.collect(dir); err != nil {
+		return err
+	}
+	err := filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && strings.HasSuffix(file, ".go") {
+			rel, _ := filepath.Rel(dir, filepath.Dir(file))
+			targets[filepath.ToSlash(rel)] = true
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var pkgDirs []string
+	for _, pkgDir := range sortedKeys(targets) {
+		if _, ok := c.dirs[pkgDir]; ok {
+			pkgDirs = append(pkgDirs, pkgDir)
+		}
+	}
+	if issues, ok := checkPackages(dir, root, module, pkgDirs); ok {
+		return errors.Join(issues...)
+	}
+
+	var issues []error
+	for _, pkgDir := range pkgDirs {
+		c.check(pkgDir)
+		issues = append(issues, c.issues[pkgDir]...)
+	}
+	return errors.Join(issues...)
+}
+
+type checker struct {
+	module string
+	fset   *token.FileSet
+	std    types.Importer
+	roots  []string
+	// dirs maps the slash separated directory of every package to its Go files by name, generated files win.
+	dirs     map[string]map[string]string
+	packages map[string]*types.Package
+	issues   map[string][]error
+	// unchecked holds the assumed names of the third-party packages that were imported.
+	unchecked map[string]bool
+}
+
+// collect adds the Go files under base that build for the current platform.
+func (c *checker) collect(base string) error {
+	return filepath.WalkDir(base, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() {
+			if file != base && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" || name == "vendor" || name == "node_modules") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			return nil
+		}
+		if match, err := build.Default.MatchFile(filepath.Dir(file), name); err != nil || !match {
+			return nil
+		}
+		rel, _ := filepath.Rel(base, filepath.Dir(file))
+		rel = filepath.ToSlash(rel)
+		if c.dirs[rel] == nil {
+			c.dirs[rel] = make(map[string]string)
+		}
+		c.dirs[rel][name] = file
+		return nil
+	})
+}
+
+// check type-checks the package in pkgDir once, recording its issues, and returns it.
+func (c *checker) check(pkgDir string) *types.Package {
+	if pkg, ok := c.packages[pkgDir]; ok {
+		return pkg
+	}
+	// Mark the package as in progress so import cycles end here instead of recursing.
+	c.packages[pkgDir] = nil
+
+	var files []*ast.File
+	for _, name := range sortedKeys(c.dirs[pkgDir]) {
+		file, err := parser.ParseFile(c.fset, c.dirs[pkgDir][name], nil, parser.ParseComments)
+		if err != nil {
+			c.issues[pkgDir] = append(c.issues[pkgDir], c.syntaxIssues(err)...)
+			continue
+		}
+		files = append(files, file)
+		if main := mainFunc(file); main != nil && file.Name.Name != "main" {
+			c.issues[pkgDir] = append(c.issues[pkgDir], c.issue(main.Pos(),
+				fmt.Sprintf("func main is declared in package %s, it only runs in package main", file.Name.Name)))
+		}
+	}
+
+	config := types.Config{
+		Importer: importerFunc(c.importPackage),
+		Error: func(err error) {
+			typeErr, ok := err.(types.Error)
+			if !ok {
+				c.issues[pkgDir] = append(c.issues[pkgDir], err)
+				return
+			}
+			if c.ignored(typeErr.Msg) {
+				return
+			}
+			c.issues[pkgDir] = append(c.issues[pkgDir], c.issue(typeErr.Pos, typeErr.Msg))
+		},
+	}
+	pkg, _ := config.Check(path.Join(c.module, pkgDir), c.fset, files, nil)
+	c.packages[pkgDir] = pkg
+	return pkg
+}
+
+func (c *checker) importPackage(importPath string) (*types.Package, error) {
+	if importPath == c.module || strings.HasPrefix(importPath, c.module+"/") {
+		pkgDir := strings.TrimPrefix(strings.TrimPrefix(importPath, c.module), "/")
+		if pkgDir == "" {
+			pkgDir = "."
+		}
+		if _, ok := c.dirs[pkgDir]; !ok {
+			return nil, fmt.Errorf("package %s is not in the project", importPath)
+		}
+		if pkg := c.check(pkgDir); pkg != nil {
+			return pkg, nil
+		}
+		return nil, fmt.Errorf("import cycle through %s", importPath)
+	}
+	pkg, err := c.std.Import(importPath)
+	if err != nil && thirdParty(importPath) {
+		c.unchecked[cleaner.AssumedName(importPath)] = true
+	}
+	return pkg, err
+}
+
+// ignored reports whether msg is about a third-party package, which are not type-checked: its failed import,
+// or a use of it under its own name, which go/types does not know for packages it could not import.
+func (c *checker) ignored(msg string) bool {
+	if importPath, ok := strings.CutPrefix(msg, "could not import "); ok {
+		importPath, _, _ = strings.Cut(importPath, " ")
+		return thirdParty(importPath) && importPath != c.module && !strings.HasPrefix(importPath, c.module+"/")
+	}
+	name, ok := strings.CutPrefix(msg, "undefined: ")
+	return ok && c.unchecked[name]
+}
+
+func thirdParty(importPath string) bool {
+	return strings.Contains(strings.Split(importPath, "/")[0], ".")
+}
+
+func (c *checker) syntaxIssues(err error) []error {
+	var list scanner.ErrorList
+	if !errors.As(err, &list) {
+		return []error{err}
+	}
+	issues := make([]error, 0, len(list))
+	for _, e := range list {
+		issues = append(issues, &Issue{Path: c.relative(e.Pos.Filename), Line: e.Pos.Line, Column: e.Pos.Column, Message: e.Msg})
+	}
+	return issues
+}
+
+func (c *checker) issue(pos token.Pos, msg string) error {
+	position := c.fset.Position(pos)
+	return &Issue{Path: c.relative(position.Filename), Line: position.Line, Column: position.Column, Message: msg}
+}
+
+func (c *checker) relative(file string) string {
+	return relative(c.roots, file)
+}
+
+// relative returns file relative to the first of roots it is in, the generated dir or the project it belongs to.
+func relative(roots []string, file string) string {
+	for _, root := range roots {
+		if root == "" {
+			continue
+		}
+		if rel, err := filepath.Rel(root, file); err == nil && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel)
+		}
+	}
+	return file
+}
+
+func mainFunc(file *ast.File) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == "main" {
+			return fn
+		}
+	}
+	return nil
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
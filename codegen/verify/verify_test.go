@@ -0,0 +1,124 @@
+package verify
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for path, content := range files {
+		path = filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func issues(err error) []string {
+	var list []string
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			var issue *Issue
+			if errors.As(e, &issue) {
+				list = append(list, issue.Error())
+			}
+		}
+	}
+	return list
+}
+
+func TestFormat(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"main.go":          "package main\nfunc main() {\n    println(1)\n}\n",
+		"app/broken.go":    "package app\n\nfunc Broken() {\n\treturn (\n}\n",
+		"app/templates.md": "not go {",
+	})
+
+	err := Format(dir)
+	assert.Equal(t, []string{"app/broken.go:5:1: expected operand, found '}'"}, issues(err))
+
+	content, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package main\n\nfunc main() {\n\tprintln(1)\n}\n", string(content))
+}
+
+func TestTypeCheck(t *testing.T) {
+	project, staged := t.TempDir(), t.TempDir()
+	writeFiles(t, project, map[string]string{
+		"go.mod":                 "module blog\n",
+		"app/types/user.go":      "package types\n\ntype User struct{ Name string }\n",
+		"app/types/user_test.go": "package types\n\nvar _ = undefinedInTests\n",
+		"app/other/other.go":     "package other\n\nvar _ = alreadyBroken\n",
+	})
+	writeFiles(t, staged, map[string]string{
+		"app/handlers/users.go": `package handlers
+
+import (
+	"fmt"
+
+	"github.com/go-chi/chi/v5"
+
+	"blog/app/types"
+)
+
+func Mount(r chi.Router) string {
+	u := types.User{Name: "x"}
+	return fmt.Sprint(u.Email, r)
+}
+`,
+		"cmd/worker/main.go": "package worker\n\nfunc main() {}\n",
+	})
+
+	err := TypeCheck(staged, project, "blog")
+	assert.Equal(t, []string{
+		"app/handlers/users.go:13:22: u.Email undefined (type types.User has no field or method Email)",
+		"cmd/worker/main.go:3:1: func main is declared in package worker, it only runs in package main",
+	}, issues(err))
+}
+
+func TestTypeCheckPasses(t *testing.T) {
+	staged := t.TempDir()
+	writeFiles(t, staged, map[string]string{
+		"main.go":         "package main\n\nimport \"blog/app\"\n\nfunc main() { app.Run() }\n",
+		"app/app.go":      "package app\n\nimport \"net/http\"\n\nfunc Run() { _ = http.ListenAndServe(\":8080\", nil) }\n",
+		"app/app_test.go": "package app\n\nimport \"testing\"\n\nfunc TestRun(t *testing.T) {}\n",
+	})
+	assert.NoError(t, TypeCheck(staged, filepath.Join(t.TempDir(), "missing"), "blog"))
+}
+
+func TestTypeCheckWithDependencies(t *testing.T) {
+	lib, project, staged := t.TempDir(), t.TempDir(), t.TempDir()
+	writeFiles(t, lib, map[string]string{
+		"go.mod":    "module example.com/lib\n\ngo 1.22\n",
+		"router.go": "package lib\n\nfunc Get(path string) {}\n",
+	})
+	writeFiles(t, project, map[string]string{
+		"go.mod":            "module blog\n\ngo 1.22\n\nrequire example.com/lib v0.0.0\n\nreplace example.com/lib => " + filepath.ToSlash(lib) + "\n",
+		"app/types/user.go": "package types\n\ntype User struct{ Name string }\n",
+	})
+	writeFiles(t, staged, map[string]string{
+		"app/handlers/users.go": `package handlers
+
+import (
+	"example.com/lib"
+
+	"blog/app/types"
+)
+
+func Mount() string {
+	lib.Gett("/users")
+	return types.User{}.Name
+}
+`,
+	})
+
+	err := TypeCheck(staged, project, "blog")
+	assert.Equal(t, []string{
+		"app/handlers/users.go:10:6: undefined: lib.Gett",
+	}, issues(err))
+}
@@ -20,42 +20,20 @@ import (
 	"embed"
 	"io/fs"
 	"net/http"
-	{{- if eq .BackendPkg "echo" }}
-	"github.com/labstack/echo/v5"
-	{{- else if eq .BackendPkg "gin" }}
-	"github.com/gin-gonic/gin"
-	{{- else if eq .BackendPkg "chi" }}
-	"github.com/go-chi/chi/v5"
-	{{- end }}
+
+	"{{.AppName}}/app/router/routing"
 )
 
 //go:embed frontend/dist/* backend/dist/* shared/*
 var uiFS embed.FS
 
-// RegisterRoutes -> registers the embedded static files with the chosen router (Echo, Gin, Chi, or http.ServeMux)
-func RegisterRoutes(mux interface{}) {
+// RegisterRoutes -> serves the embedded static files under /frontend and /backend
+func RegisterRoutes(router routing.Router) {
 	frontendFS, _ := fs.Sub(uiFS, "frontend/dist")
 	backendFS, _ := fs.Sub(uiFS, "backend/dist")
 
-	switch m := mux.(type) {
-	{{- if eq .BackendPkg "echo" }}
-	case *echo.Echo:
-		m.GET("/frontend/*", echo.WrapHandler(http.StripPrefix("/frontend", http.FileServer(http.FS(frontendFS)))))
-		m.GET("/backend/*", echo.WrapHandler(http.StripPrefix("/backend", http.FileServer(http.FS(backendFS)))))
-	{{- else if eq .BackendPkg "gin" }}
-	case *gin.Engine:
-		m.StaticFS("/frontend", http.FS(frontendFS))
-		m.StaticFS("/backend", http.FS(backendFS))
-	{{- else if eq .BackendPkg "chi" }}
-	case chi.Router:
-		m.Handle("/frontend/*", http.StripPrefix("/frontend", http.FileServer(http.FS(frontendFS))))
-		m.Handle("/backend/*", http.StripPrefix("/backend", http.FileServer(http.FS(backendFS))))
-	{{- else }}
-	case *http.ServeMux:
-		m.Handle("/frontend/", http.StripPrefix("/frontend", http.FileServer(http.FS(frontendFS))))
-		m.Handle("/backend/", http.StripPrefix("/backend", http.FileServer(http.FS(backendFS))))
-	{{- end }}
-	}
+	router.Static("/frontend", http.FS(frontendFS))
+	router.Static("/backend", http.FS(backendFS))
 }
 			`
 		},
@@ -327,7 +305,8 @@ func (g *GenUiPlugin) Version() string {
 }
 
 func (g *GenUiPlugin) Dependencies() []string {
-	return []string{}
+	// app/web imports app/router/routing.
+	return []string{"GenRouterPlugin"}
 }
 
 func (g *GenUiPlugin) AuthorName() string {
@@ -347,7 +326,11 @@ func (g *GenUiPlugin) GitHub() string {
 }
 
 func (g *GenUiPlugin) Generate(data config.ProjectData) error {
-	return general.GenerateFiles(data, g.Files)
+	return general.GeneratePluginFiles(g.Name(), data, g.Files)
+}
+
+func (g *GenUiPlugin) Templates() map[string]func() string {
+	return g.Files
 }
 
 func NewGenUiPlugin(data config.ProjectData) *GenUiPlugin {
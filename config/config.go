@@ -1,5 +1,11 @@
 package config
 
+import (
+	"crypto/rand"
+	"io"
+	"time"
+)
+
 type Configurable interface {
 	GetAppName() string
 }
@@ -23,6 +29,43 @@ type ProjectData struct {
 	Port                   int
 	UiFramework            string
 	VersionedBackendImport string
+	// Without lists the features left out of the project, see general.Features.
+	Without []string
+	// OutputDir receives the generated files instead of ProjectDir while they are staged.
+	OutputDir string
+	// Clock returns the time used in generated files, like migration names, time.Now when nil.
+	Clock func() time.Time `json:"-"`
+	// Entropy is read for the random parts of generated secrets like Fingerprint, crypto/rand when nil.
+	Entropy io.Reader `json:"-"`
+}
+
+// Now returns the current time of the project's Clock.
+func (p *ProjectData) Now() time.Time {
+	if p.Clock == nil {
+		return time.Now()
+	}
+	return p.Clock()
+}
+
+// Random returns the project's source of random bytes.
+func (p *ProjectData) Random() io.Reader {
+	if p.Entropy == nil {
+		return rand.Reader
+	}
+	return p.Entropy
+}
+
+// ConfigFileName returns the name of the config file generated at the project root for format,
+// one of env, json, toml or yaml.
+func ConfigFileName(format string) string {
+	switch format {
+	case "env":
+		return ".env"
+	case "json", "toml":
+		return "config." + format
+	default:
+		return "config.yaml"
+	}
 }
 
 type ResourcePluginConfig struct {
@@ -1 +1,20 @@
 package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigFileName(t *testing.T) {
+	tests := map[string]string{
+		"env":  ".env",
+		"json": "config.json",
+		"toml": "config.toml",
+		"yaml": "config.yaml",
+		"":     "config.yaml",
+	}
+	for format, want := range tests {
+		assert.Equal(t, want, ConfigFileName(format), format)
+	}
+}
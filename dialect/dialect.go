@@ -0,0 +1,136 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect describes how gost talks SQL to one of the supported database drivers.
+// It mirrors the dialects scaffolded into generated projects under plugins/db/dialects.
+type Dialect struct {
+	// Name is the normalized dialect name, e.g. "sqlite", "postgresql" or "mysql".
+	Name string
+	// DriverName is the database/sql driver registered for this dialect.
+	DriverName string
+	// PrimaryKey is the column definition used for auto incrementing id columns.
+	PrimaryKey string
+	// Types maps a gost field kind (string, text, int, ...) to the column type of this dialect.
+	Types map[string]string
+	// Now is the expression used as a default value for timestamp columns.
+	Now string
+	// numbered placeholders ($1, $2, ...) instead of positional ones (?).
+	numbered bool
+	// blocks are the BEGIN ... END bodies of triggers, whose statements end in semicolons too.
+	blocks bool
+}
+
+var sqlite = &Dialect{
+	Name:       "sqlite",
+	DriverName: "sqlite",
+	PrimaryKey: "INTEGER PRIMARY KEY AUTOINCREMENT",
+	Types: map[string]string{
+		"string":  "TEXT",
+		"text":    "TEXT",
+		"int":     "INTEGER",
+		"bigint":  "INTEGER",
+		"float":   "REAL",
+		"decimal": "NUMERIC",
+		"bool":    "BOOLEAN",
+		"time":    "DATETIME",
+		"date":    "DATE",
+		"uuid":    "TEXT",
+		"json":    "TEXT",
+		"ref":     "INTEGER",
+	},
+	Now:    "CURRENT_TIMESTAMP",
+	blocks: true,
+}
+
+var postgresql = &Dialect{
+	Name:       "postgresql",
+	DriverName: "postgres",
+	PrimaryKey: "BIGSERIAL PRIMARY KEY",
+	Types: map[string]string{
+		"string":  "VARCHAR(255)",
+		"text":    "TEXT",
+		"int":     "INTEGER",
+		"bigint":  "BIGINT",
+		"float":   "DOUBLE PRECISION",
+		"decimal": "NUMERIC(12, 2)",
+		"bool":    "BOOLEAN",
+		"time":    "TIMESTAMPTZ",
+		"date":    "DATE",
+		"uuid":    "UUID",
+		"json":    "JSONB",
+		"ref":     "BIGINT",
+	},
+	Now:      "CURRENT_TIMESTAMP",
+	numbered: true,
+}
+
+var mysql = &Dialect{
+	Name:       "mysql",
+	DriverName: "mysql",
+	PrimaryKey: "BIGINT AUTO_INCREMENT PRIMARY KEY",
+	Types: map[string]string{
+		"string":  "VARCHAR(255)",
+		"text":    "TEXT",
+		"int":     "INT",
+		"bigint":  "BIGINT",
+		"float":   "DOUBLE",
+		"decimal": "DECIMAL(12, 2)",
+		"bool":    "BOOLEAN",
+		"time":    "DATETIME",
+		"date":    "DATE",
+		"uuid":    "CHAR(36)",
+		"json":    "JSON",
+		"ref":     "BIGINT",
+	},
+	Now: "CURRENT_TIMESTAMP",
+}
+
+// For returns the dialect matching a db driver name as stored in GostConfig/ProjectData,
+// e.g. "Sqlite", "sqlite3", "Postgresql", "postgres" or "MySql".
+func For(driver string) (*Dialect, error) {
+	switch strings.ToLower(strings.TrimSpace(driver)) {
+	case "", "sqlite", "sqlite3":
+		return sqlite, nil
+	case "postgresql", "postgres", "pg":
+		return postgresql, nil
+	case "mysql", "mariadb":
+		return mysql, nil
+	default:
+		return nil, fmt.Errorf(">>Gost>> unsupported sql dialect %q", driver)
+	}
+}
+
+// ColumnType returns the column type for a gost field kind.
+func (d *Dialect) ColumnType(kind string) (string, error) {
+	t, ok := d.Types[kind]
+	if !ok {
+		return "", fmt.Errorf(">>Gost>> %s has no column type for %q", d.Name, kind)
+	}
+	return t, nil
+}
+
+// Placeholder returns the bind parameter for the n-th (1 based) argument of a statement.
+func (d *Dialect) Placeholder(n int) string {
+	if d.numbered {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+// Placeholders returns n comma separated bind parameters starting at the first argument.
+func (d *Dialect) Placeholders(n int) string {
+	return d.PlaceholdersFrom(1, n)
+}
+
+// PlaceholdersFrom returns n comma separated bind parameters starting at the given argument index.
+func (d *Dialect) PlaceholdersFrom(start, n int) string {
+	ps := make([]string, n)
+	for i := range ps {
+		ps[i] = d.Placeholder(start + i)
+	}
+	return strings.Join(ps, ", ")
+}
@@ -0,0 +1,90 @@
+package dialect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStatements(t *testing.T) {
+	script := `
+-- Create settings table
+CREATE TABLE settings (id INTEGER PRIMARY KEY, value TEXT);
+
+INSERT INTO settings (value) VALUES ('a;b'), ('it''s; fine');
+/* block; comment */ DELETE FROM settings WHERE value = "x;y";
+-- trailing comment only
+`
+	statements := sqlite.SplitStatements(script)
+	assert.Len(t, statements, 3)
+	assert.Equal(t, "-- Create settings table\nCREATE TABLE settings (id INTEGER PRIMARY KEY, value TEXT)", statements[0])
+	assert.Equal(t, "INSERT INTO settings (value) VALUES ('a;b'), ('it''s; fine')", statements[1])
+	assert.Equal(t, `/* block; comment */ DELETE FROM settings WHERE value = "x;y"`, statements[2])
+}
+
+func TestSplitStatementsKeepsDollarQuotedBodies(t *testing.T) {
+	script := `
+CREATE FUNCTION touch() RETURNS trigger AS $$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+CREATE FUNCTION greet() RETURNS text AS $body$ SELECT 'a;b'; $body$ LANGUAGE sql;
+UPDATE posts SET title = $1 WHERE id = $2;
+`
+	statements := postgresql.SplitStatements(script)
+	assert.Len(t, statements, 3)
+	assert.Equal(t, "CREATE FUNCTION touch() RETURNS trigger AS $$\nBEGIN\n    NEW.updated_at = now();\n    RETURN NEW;\nEND;\n$$ LANGUAGE plpgsql", statements[0])
+	assert.Equal(t, "CREATE FUNCTION greet() RETURNS text AS $body$ SELECT 'a;b'; $body$ LANGUAGE sql", statements[1])
+	assert.Equal(t, "UPDATE posts SET title = $1 WHERE id = $2", statements[2])
+}
+
+func TestSplitStatementsKeepsTriggerBodies(t *testing.T) {
+	script := `
+BEGIN;
+CREATE TEMP TRIGGER touch_posts AFTER UPDATE ON posts
+BEGIN
+    UPDATE posts SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+    INSERT INTO audit (kind) VALUES (CASE WHEN NEW.title = '' THEN 'untitled' ELSE 'titled' END);
+END;
+COMMIT;
+`
+	statements := sqlite.SplitStatements(script)
+	assert.Len(t, statements, 3)
+	assert.Equal(t, "BEGIN", statements[0])
+	assert.Equal(t, "CREATE TEMP TRIGGER touch_posts AFTER UPDATE ON posts\nBEGIN\n    UPDATE posts SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;\n    INSERT INTO audit (kind) VALUES (CASE WHEN NEW.title = '' THEN 'untitled' ELSE 'titled' END);\nEND", statements[1])
+	assert.Equal(t, "COMMIT", statements[2])
+
+	assert.Len(t, mysql.SplitStatements(script), 5)
+}
+
+func TestPlaceholders(t *testing.T) {
+	sqlite, _ := For("Sqlite")
+	postgres, _ := For("Postgresql")
+	assert.Equal(t, "?, ?, ?", sqlite.Placeholders(3))
+	assert.Equal(t, "$3, $4", postgres.PlaceholdersFrom(3, 2))
+
+	_, err := For("MongoDb")
+	assert.Error(t, err)
+}
+
+func TestColumnType(t *testing.T) {
+	for _, driver := range []string{"sqlite3", "postgres", "MariaDB"} {
+		d, err := For(driver)
+		require.NoError(t, err, driver)
+		for _, kind := range []string{"string", "text", "int", "bigint", "float", "decimal", "bool", "time", "date", "uuid", "json", "ref"} {
+			_, err := d.ColumnType(kind)
+			assert.NoError(t, err, "%s has no column type for %s", d.Name, kind)
+		}
+	}
+
+	postgres, _ := For("postgres")
+	typ, err := postgres.ColumnType("json")
+	require.NoError(t, err)
+	assert.Equal(t, "JSONB", typ)
+
+	_, err = postgres.ColumnType("money")
+	assert.EqualError(t, err, `>>Gost>> postgresql has no column type for "money"`)
+}
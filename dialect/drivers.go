@@ -0,0 +1,9 @@
+package dialect
+
+import (
+	// Database drivers for every supported dialect. The sqlite one is pure Go so it works
+	// out of the box, including in builds made with CGO_ENABLED=0.
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
@@ -0,0 +1,141 @@
+package dialect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SplitStatements splits a SQL script into its individual statements on top level semicolons,
+// ignoring semicolons inside quoted strings, quoted identifiers, comments, the dollar-quoted
+// bodies ($$ ... $$ or $tag$ ... $tag$) of Postgres functions and, on sqlite, the BEGIN ... END
+// bodies of triggers. Statements made only of comments and whitespace are dropped.
+func (d *Dialect) SplitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	runes := []rune(script)
+	// lead holds the first words of the current statement, depth the BEGIN and CASE blocks open in it.
+	var lead []string
+	depth := 0
+
+	flush := func() {
+		stmt := strings.TrimSpace(current.String())
+		if stmt != "" && !onlyComments(stmt) {
+			statements = append(statements, stmt)
+		}
+		current.Reset()
+		lead = lead[:0]
+		depth = 0
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'' || r == '"' || r == '`':
+			// Copy the quoted section verbatim, doubled quotes are escapes and simply reopen it.
+			current.WriteRune(r)
+			for i++; i < len(runes); i++ {
+				current.WriteRune(runes[i])
+				if runes[i] == r {
+					break
+				}
+			}
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for ; i < len(runes) && runes[i] != '\n'; i++ {
+				current.WriteRune(runes[i])
+			}
+			if i < len(runes) {
+				current.WriteRune(runes[i])
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := strings.Index(string(runes[i+2:]), "*/")
+			if end == -1 {
+				current.WriteString(string(runes[i:]))
+				i = len(runes)
+				continue
+			}
+			block := string(runes[i+2:])[:end]
+			current.WriteString("/*" + block + "*/")
+			i += 2 + len([]rune(block)) + 1
+		case r == '$' && dollarTag(runes, i) != "":
+			tag := dollarTag(runes, i)
+			rest := string(runes[i+len([]rune(tag)):])
+			end := strings.Index(rest, tag)
+			if end == -1 {
+				current.WriteString(string(runes[i:]))
+				i = len(runes)
+				continue
+			}
+			body := tag + rest[:end] + tag
+			current.WriteString(body)
+			i += len([]rune(body)) - 1
+		case isIdentRune(r) && (i == 0 || !isIdentRune(runes[i-1])):
+			end := i
+			for end < len(runes) && isIdentRune(runes[end]) {
+				end++
+			}
+			word := strings.ToUpper(string(runes[i:end]))
+			current.WriteString(string(runes[i:end]))
+			i = end - 1
+			if len(lead) < 3 {
+				lead = append(lead, word)
+			}
+			switch {
+			case !d.blocks:
+			case word == "BEGIN" || word == "CASE":
+				depth++
+			case word == "END" && depth > 0:
+				depth--
+			}
+		case r == ';' && depth > 0 && isTrigger(lead):
+			current.WriteRune(r)
+		case r == ';':
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return statements
+}
+
+// dollarTag returns the opening tag of the dollar-quoted string at i, like $$ or $body$, or "" when
+// there is none there, as for the $1 placeholders or a $ inside an identifier.
+func dollarTag(runes []rune, i int) string {
+	if i > 0 && isIdentRune(runes[i-1]) {
+		return ""
+	}
+	for j := i + 1; j < len(runes); j++ {
+		switch {
+		case runes[j] == '$':
+			return string(runes[i : j+1])
+		case unicode.IsDigit(runes[j]) && j == i+1, !isIdentRune(runes[j]):
+			return ""
+		}
+	}
+	return ""
+}
+
+// isTrigger reports whether a statement starting with the words lead creates a trigger.
+func isTrigger(lead []string) bool {
+	if len(lead) > 1 && (lead[1] == "TEMP" || lead[1] == "TEMPORARY") {
+		lead = append([]string{lead[0]}, lead[2:]...)
+	}
+	return len(lead) > 1 && lead[0] == "CREATE" && lead[1] == "TRIGGER"
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func onlyComments(stmt string) bool {
+	for _, line := range strings.Split(stmt, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			if strings.HasPrefix(line, "/*") && strings.HasSuffix(line, "*/") {
+				continue
+			}
+			return false
+		}
+	}
+	return true
+}
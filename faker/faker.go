@@ -1,9 +1,435 @@
 package faker
 
-func FakeDBData(appName string) error {
-	return nil
+import (
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/theHamdiz/gost/dialect"
+	"github.com/theHamdiz/gost/project"
+)
+
+var (
+	firstNames = []string{"Ahmad", "Sara", "Omar", "Lina", "John", "Maria", "Yusuf", "Nora", "David", "Aisha", "Liam", "Emma", "Karim", "Mona", "Noah", "Olivia", "Hassan", "Zeina", "Lucas", "Mia"}
+	lastNames  = []string{"Hamdi", "Smith", "Haddad", "Garcia", "Khalil", "Johnson", "Nasser", "Brown", "Mansour", "Miller", "Saleh", "Davis", "Farouk", "Wilson", "Aziz", "Taylor"}
+	words      = []string{"go", "starter", "lazy", "fast", "simple", "plugin", "router", "handler", "model", "gopher", "cloud", "stream", "signal", "vector", "pixel", "orbit", "river", "stone", "ember", "harbor", "meadow", "lantern", "quartz", "summit"}
+	domains    = []string{"example.com", "example.org", "mail.test", "gost.dev", "hamdiz.me"}
+	cities     = []string{"Cairo", "Berlin", "Lisbon", "Toronto", "Dubai", "Tokyo", "Austin", "Nairobi", "Oslo", "Madrid"}
+	countries  = []string{"Egypt", "Germany", "Portugal", "Canada", "UAE", "Japan", "USA", "Kenya", "Norway", "Spain"}
+	streets    = []string{"Main St", "Nile Corniche", "Oak Avenue", "Harbor Road", "Market Street", "Elm Lane"}
+	colors     = []string{"red", "green", "blue", "teal", "pink", "black", "white", "orange"}
+)
+
+// epoch bounds generated timestamps so output only depends on the seed, never on the current time.
+var epoch = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+const timeSpan = 5 * 365 * 24 * time.Hour
+
+// Faker generates deterministic fake values from a seeded random source.
+type Faker struct {
+	rand *rand.Rand
+}
+
+func NewFaker(seed int64) *Faker {
+	return &Faker{rand: rand.New(rand.NewSource(seed))}
+}
+
+func (f *Faker) pick(list []string) string {
+	return list[f.rand.Intn(len(list))]
+}
+
+func (f *Faker) FirstName() string {
+	return f.pick(firstNames)
+}
+
+func (f *Faker) LastName() string {
+	return f.pick(lastNames)
+}
+
+func (f *Faker) Name() string {
+	return f.FirstName() + " " + f.LastName()
+}
+
+func (f *Faker) Username() string {
+	return strings.ToLower(f.FirstName()) + fmt.Sprintf("%d", f.rand.Intn(1000))
+}
+
+func (f *Faker) Email() string {
+	return fmt.Sprintf("%s.%s%d@%s", strings.ToLower(f.FirstName()), strings.ToLower(f.LastName()), f.rand.Intn(100), f.pick(domains))
+}
+
+func (f *Faker) Words(n int) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = f.pick(words)
+	}
+	return out
+}
+
+func (f *Faker) Sentence() string {
+	s := strings.Join(f.Words(4+f.rand.Intn(6)), " ")
+	return strings.ToUpper(s[:1]) + s[1:] + "."
+}
+
+func (f *Faker) Paragraph() string {
+	sentences := make([]string, 3+f.rand.Intn(3))
+	for i := range sentences {
+		sentences[i] = f.Sentence()
+	}
+	return strings.Join(sentences, " ")
+}
+
+func (f *Faker) Slug() string {
+	return strings.Join(f.Words(3), "-")
+}
+
+func (f *Faker) Phone() string {
+	return fmt.Sprintf("+1-%03d-%03d-%04d", 200+f.rand.Intn(800), f.rand.Intn(1000), f.rand.Intn(10000))
+}
+
+func (f *Faker) URL() string {
+	return fmt.Sprintf("https://%s/%s", f.pick(domains), f.Slug())
+}
+
+func (f *Faker) UUID() string {
+	b := make([]byte, 16)
+	f.rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func (f *Faker) Time() time.Time {
+	return epoch.Add(time.Duration(f.rand.Int63n(int64(timeSpan)))).Truncate(time.Second)
+}
+
+func (f *Faker) Date() string {
+	return f.Time().Format("2006-01-02")
 }
 
-func GetFakeData(appName string) error {
-	return nil
+func (f *Faker) Int(min, max int) int {
+	return min + f.rand.Intn(max-min+1)
+}
+
+func (f *Faker) Float(min, max float64) float64 {
+	return float64(int((min+f.rand.Float64()*(max-min))*100)) / 100
+}
+
+func (f *Faker) Bool() bool {
+	return f.rand.Intn(2) == 1
+}
+
+// Value returns a fake value for column, inferred from its name first and its type second.
+func (f *Faker) Value(column Column) interface{} {
+	name := strings.ToLower(column.Name)
+	typ := strings.ToLower(column.Type)
+
+	switch {
+	case strings.Contains(name, "email"):
+		return f.Email()
+	case name == "first_name" || name == "firstname":
+		return f.FirstName()
+	case name == "last_name" || name == "lastname" || name == "surname":
+		return f.LastName()
+	case name == "username" || name == "user_name" || name == "login" || name == "handle":
+		return f.Username()
+	case name == "name" || name == "full_name" || name == "fullname" || name == "author":
+		return f.Name()
+	case strings.Contains(name, "password"):
+		return "$2a$10$" + strings.ReplaceAll(f.UUID(), "-", "")
+	case strings.Contains(name, "phone") || strings.Contains(name, "mobile"):
+		return f.Phone()
+	case strings.Contains(name, "url") || strings.Contains(name, "website") || strings.Contains(name, "link"):
+		return f.URL()
+	case name == "slug":
+		return f.Slug()
+	case strings.Contains(name, "uuid") || typ == "uuid":
+		return f.UUID()
+	case name == "city":
+		return f.pick(cities)
+	case name == "country":
+		return f.pick(countries)
+	case strings.Contains(name, "address") || name == "street":
+		return fmt.Sprintf("%d %s", f.Int(1, 999), f.pick(streets))
+	case name == "zip" || strings.Contains(name, "postal"):
+		return fmt.Sprintf("%05d", f.rand.Intn(100000))
+	case strings.Contains(name, "color") || strings.Contains(name, "colour"):
+		return f.pick(colors)
+	case name == "title" || name == "subject" || name == "headline":
+		return strings.TrimSuffix(f.Sentence(), ".")
+	case name == "description" || name == "body" || name == "content" || name == "bio" || name == "summary":
+		return f.Paragraph()
+	case name == "age":
+		return f.Int(18, 90)
+	case strings.Contains(name, "price") || strings.Contains(name, "amount") || strings.Contains(name, "total"):
+		return f.Float(1, 1000)
+	case strings.HasPrefix(name, "is_") || strings.HasPrefix(name, "has_") || strings.HasPrefix(name, "can_"):
+		return f.Bool()
+	case strings.HasSuffix(name, "_at") || strings.HasSuffix(name, "_on"):
+		return f.Time()
+	case strings.Contains(name, "birth") || strings.HasSuffix(name, "_date"):
+		return f.Date()
+	}
+
+	switch {
+	case strings.Contains(typ, "bool"):
+		return f.Bool()
+	case strings.Contains(typ, "int") || typ == "serial" || typ == "bigserial":
+		return f.Int(0, 10000)
+	case strings.Contains(typ, "real") || strings.Contains(typ, "float") || strings.Contains(typ, "double") ||
+		strings.Contains(typ, "numeric") || strings.Contains(typ, "decimal"):
+		return f.Float(0, 10000)
+	case strings.Contains(typ, "timestamp") || strings.Contains(typ, "datetime") || strings.Contains(typ, "time"):
+		return f.Time()
+	case strings.Contains(typ, "date"):
+		return f.Date()
+	case strings.Contains(typ, "json"):
+		return "{}"
+	case strings.Contains(typ, "text") && !strings.Contains(typ, "tiny"):
+		return f.Sentence()
+	default:
+		return strings.Join(f.Words(2), " ")
+	}
+}
+
+// uniqueVariant turns a colliding value into a new candidate for a unique column.
+func uniqueVariant(value interface{}, attempt int) interface{} {
+	switch v := value.(type) {
+	case string:
+		if at := strings.Index(v, "@"); at != -1 {
+			return fmt.Sprintf("%s+%d%s", v[:at], attempt, v[at:])
+		}
+		return fmt.Sprintf("%s-%d", v, attempt)
+	case int:
+		return v + attempt*10007
+	case float64:
+		return v + float64(attempt)
+	case time.Time:
+		return v.Add(time.Duration(attempt) * time.Second)
+	default:
+		return value
+	}
+}
+
+// Options controls how fake rows are generated.
+type Options struct {
+	Count     int
+	Seed      int64
+	BatchSize int
+}
+
+// Filler inserts fake rows into a table of an open database.
+type Filler struct {
+	db      *sql.DB
+	dialect *dialect.Dialect
+	faker   *Faker
+}
+
+func NewFiller(db *sql.DB, d *dialect.Dialect, seed int64) *Filler {
+	return &Filler{
+		db:      db,
+		dialect: d,
+		faker:   NewFaker(seed),
+	}
+}
+
+// Rows generates count rows of fake values for the fillable columns, honoring unique constraints
+// against both the generated rows and the rows already stored in the table.
+func (fl *Filler) Rows(table string, columns []Column, count int) ([]Column, [][]interface{}, error) {
+	fillable := Fillable(columns)
+	if len(fillable) == 0 {
+		return nil, nil, fmt.Errorf(">>Gost>> table %s has no columns to fill", table)
+	}
+
+	refs := make(map[string][]interface{})
+	taken := make(map[string]map[string]bool)
+	for _, column := range fillable {
+		if column.RefTable != "" {
+			ids, err := fl.values(column.RefTable, column.RefColumn)
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(ids) == 0 {
+				return nil, nil, fmt.Errorf(">>Gost>> %s.%s references %s which has no rows yet, fake it first", table, column.Name, column.RefTable)
+			}
+			refs[column.Name] = ids
+		}
+		if column.Unique {
+			existing, err := fl.values(table, column.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+			taken[column.Name] = make(map[string]bool, len(existing)+count)
+			for _, v := range existing {
+				taken[column.Name][fmt.Sprint(v)] = true
+			}
+		}
+	}
+
+	rows := make([][]interface{}, count)
+	for i := range rows {
+		row := make([]interface{}, len(fillable))
+		for j, column := range fillable {
+			var value interface{}
+			if ids, ok := refs[column.Name]; ok {
+				value = ids[fl.faker.rand.Intn(len(ids))]
+			} else {
+				value = fl.faker.Value(column)
+			}
+			if seen, ok := taken[column.Name]; ok {
+				candidate := value
+				for attempt := 1; seen[fmt.Sprint(candidate)]; attempt++ {
+					if attempt > 10000 || refs[column.Name] != nil {
+						return nil, nil, fmt.Errorf(">>Gost>> could not generate a unique value for %s.%s", table, column.Name)
+					}
+					candidate = uniqueVariant(value, attempt)
+				}
+				value = candidate
+				seen[fmt.Sprint(value)] = true
+			}
+			row[j] = value
+		}
+		rows[i] = row
+	}
+	return fillable, rows, nil
+}
+
+// Fill generates and inserts opts.Count rows into table inside a single transaction, in batches.
+func (fl *Filler) Fill(table string, columns []Column, opts Options) (int, error) {
+	fillable, rows, err := fl.Rows(table, columns, opts.Count)
+	if err != nil {
+		return 0, err
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	// Stay well below the bind parameter limits of every dialect.
+	if maxRows := 900 / len(fillable); batchSize > maxRows {
+		batchSize = max(maxRows, 1)
+	}
+
+	names := make([]string, len(fillable))
+	for i, column := range fillable {
+		names[i] = column.Name
+	}
+
+	tx, err := fl.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	for start := 0; start < len(rows); start += batchSize {
+		batch := rows[start:min(start+batchSize, len(rows))]
+		groups := make([]string, len(batch))
+		args := make([]interface{}, 0, len(batch)*len(fillable))
+		for i, row := range batch {
+			groups[i] = "(" + fl.dialect.PlaceholdersFrom(len(args)+1, len(row)) + ")"
+			args = append(args, row...)
+		}
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", table, strings.Join(names, ", "), strings.Join(groups, ", "))
+		if _, err := tx.Exec(query, args...); err != nil {
+			_ = tx.Rollback()
+			return 0, fmt.Errorf(">>Gost>> failed to insert fake rows %d-%d into %s: %w", start+1, start+len(batch), table, err)
+		}
+	}
+	return len(rows), tx.Commit()
+}
+
+// values returns every value of column in table, used for foreign keys and unique columns.
+func (fl *Filler) values(table, column string) ([]interface{}, error) {
+	rows, err := fl.db.Query(fmt.Sprintf("SELECT %s FROM %s ORDER BY %s", column, table, column))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			fmt.Println(">>Gost>> Error closing rows:", err)
+		}
+	}(rows)
+
+	var values []interface{}
+	for rows.Next() {
+		var v interface{}
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		if b, ok := v.([]byte); ok {
+			v = string(b)
+		}
+		values = append(values, v)
+	}
+	return values, rows.Err()
+}
+
+// FakeDBData fills table of the gost project at projectDir with fake rows and returns how many were inserted.
+// When modelFile is set the columns are read from the model's ColumnMappings instead of the database schema.
+func FakeDBData(projectDir, table, modelFile string, opts Options) (int, error) {
+	var inserted int
+	err := withTable(projectDir, table, modelFile, opts.Seed, func(fl *Filler, table string, columns []Column) error {
+		var err error
+		inserted, err = fl.Fill(table, columns, opts)
+		return err
+	})
+	return inserted, err
+}
+
+// GetFakeData generates the rows FakeDBData would insert for the same seed without touching the database.
+func GetFakeData(projectDir, table, modelFile string, opts Options) ([]string, [][]interface{}, error) {
+	var names []string
+	var rows [][]interface{}
+	err := withTable(projectDir, table, modelFile, opts.Seed, func(fl *Filler, table string, columns []Column) error {
+		fillable, generated, err := fl.Rows(table, columns, opts.Count)
+		if err != nil {
+			return err
+		}
+		for _, column := range fillable {
+			names = append(names, column.Name)
+		}
+		rows = generated
+		return nil
+	})
+	return names, rows, err
+}
+
+// withTable opens the project's database and resolves the columns of table, from modelFile when set.
+func withTable(projectDir, table, modelFile string, seed int64, fn func(fl *Filler, table string, columns []Column) error) error {
+	data, err := project.Load(projectDir)
+	if err != nil {
+		return err
+	}
+	db, d, err := project.OpenDB(data)
+	if err != nil {
+		return err
+	}
+	defer func(db *sql.DB) {
+		if err := db.Close(); err != nil {
+			fmt.Println(">>Gost>> Error closing database:", err)
+		}
+	}(db)
+
+	var columns []Column
+	if modelFile != "" {
+		var modelTable string
+		modelTable, columns, err = ModelColumns(modelFile)
+		if err != nil {
+			return err
+		}
+		if table == "" {
+			table = modelTable
+		}
+		// The model knows the Go types, the schema still knows the constraints.
+		if schema, err := Inspect(db, d, table); err == nil {
+			columns = mergeConstraints(columns, schema)
+		}
+	} else {
+		columns, err = Inspect(db, d, table)
+		if err != nil {
+			return err
+		}
+	}
+
+	return fn(NewFiller(db, d, seed), table, columns)
 }
@@ -0,0 +1,104 @@
+package faker
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theHamdiz/gost/codegen/model"
+	"github.com/theHamdiz/gost/dialect"
+)
+
+func openDB(t *testing.T) (*sql.DB, *dialect.Dialect) {
+	db, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "data.db")+"?_pragma=foreign_keys(1)")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT NOT NULL, email TEXT NOT NULL UNIQUE, created_at TIMESTAMP);
+CREATE TABLE posts (id INTEGER PRIMARY KEY, title TEXT, published BOOLEAN, user_id INTEGER NOT NULL REFERENCES users(id));`)
+	require.NoError(t, err)
+	d, _ := dialect.For("sqlite")
+	return db, d
+}
+
+func TestInspectSQLite(t *testing.T) {
+	db, d := openDB(t)
+
+	columns, err := Inspect(db, d, "posts")
+	assert.NoError(t, err)
+	assert.Equal(t, Column{Name: "id", Type: "INTEGER", PrimaryKey: true, AutoIncrement: true}, columns[0])
+	assert.Equal(t, "users", columns[3].RefTable)
+	assert.Equal(t, "id", columns[3].RefColumn)
+
+	users, err := Inspect(db, d, "users")
+	assert.NoError(t, err)
+	assert.True(t, users[2].Unique)
+
+	_, err = Inspect(db, d, "nowhere")
+	assert.Error(t, err)
+}
+
+func TestRowsAreDeterministic(t *testing.T) {
+	db, d := openDB(t)
+	columns, err := Inspect(db, d, "users")
+	require.NoError(t, err)
+
+	_, first, err := NewFiller(db, d, 42).Rows("users", columns, 20)
+	assert.NoError(t, err)
+	_, second, err := NewFiller(db, d, 42).Rows("users", columns, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	_, other, err := NewFiller(db, d, 7).Rows("users", columns, 20)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, other)
+}
+
+func TestFillRespectsUniqueAndForeignKeys(t *testing.T) {
+	db, d := openDB(t)
+	users, err := Inspect(db, d, "users")
+	require.NoError(t, err)
+	posts, err := Inspect(db, d, "posts")
+	require.NoError(t, err)
+
+	_, err = NewFiller(db, d, 1).Fill("posts", posts, Options{Count: 5})
+	assert.ErrorContains(t, err, "has no rows yet")
+
+	// Filling twice with the same seed must not collide with the emails already stored.
+	for i := 0; i < 2; i++ {
+		inserted, err := NewFiller(db, d, 1).Fill("users", users, Options{Count: 300, BatchSize: 50})
+		assert.NoError(t, err)
+		assert.Equal(t, 300, inserted)
+	}
+	inserted, err := NewFiller(db, d, 1).Fill("posts", posts, Options{Count: 500})
+	assert.NoError(t, err)
+	assert.Equal(t, 500, inserted)
+
+	var orphans int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM posts WHERE user_id NOT IN (SELECT id FROM users)").Scan(&orphans))
+	assert.Zero(t, orphans)
+}
+
+func TestModelColumns(t *testing.T) {
+	fields, err := model.ParseFields([]string{"title:string", "rating:float", "bio:text:null", "author_id:ref:User"})
+	require.NoError(t, err)
+	src, err := model.NewModel("Post", fields).Source()
+	require.NoError(t, err)
+	file := filepath.Join(t.TempDir(), "post.go")
+	require.NoError(t, os.WriteFile(file, []byte(src), 0644))
+
+	table, columns, err := ModelColumns(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "posts", table)
+	byName := make(map[string]Column)
+	for _, column := range columns {
+		byName[column.Name] = column
+	}
+	assert.Equal(t, "string", byName["title"].Type)
+	assert.Equal(t, "float64", byName["rating"].Type)
+	assert.True(t, byName["bio"].Nullable)
+	assert.Equal(t, "time.Time", byName["created_at"].Type)
+	assert.NotContains(t, byName, "id")
+}
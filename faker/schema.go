@@ -0,0 +1,373 @@
+package faker
+
+import (
+	"database/sql"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/theHamdiz/gost/dialect"
+)
+
+// Column describes a table column as far as fake data generation cares.
+type Column struct {
+	Name          string
+	Type          string
+	Nullable      bool
+	Unique        bool
+	PrimaryKey    bool
+	AutoIncrement bool
+	RefTable      string
+	RefColumn     string
+}
+
+// Fillable drops the columns the database fills on its own, i.e. auto incrementing primary keys.
+func Fillable(columns []Column) []Column {
+	var fillable []Column
+	for _, column := range columns {
+		if column.PrimaryKey && column.AutoIncrement {
+			continue
+		}
+		fillable = append(fillable, column)
+	}
+	return fillable
+}
+
+// Inspect reads the columns, unique constraints and foreign keys of table.
+func Inspect(db *sql.DB, d *dialect.Dialect, table string) ([]Column, error) {
+	var columns []Column
+	var err error
+	switch d.Name {
+	case "postgresql":
+		columns, err = inspectPostgres(db, table)
+	case "mysql":
+		columns, err = inspectMySQL(db, table)
+	default:
+		columns, err = inspectSQLite(db, table)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf(">>Gost>> table %s does not exist or has no columns", table)
+	}
+	return columns, nil
+}
+
+func inspectSQLite(db *sql.DB, table string) ([]Column, error) {
+	var columns []Column
+	byName := make(map[string]*Column)
+	var pkCount int
+
+	err := queryRows(db, fmt.Sprintf("PRAGMA table_info(%s)", quoteSQLite(table)), func(rows *sql.Rows) error {
+		var cid, notNull, pk int
+		var name, typ string
+		var dflt sql.NullString
+		if err := rows.Scan(&cid, &name, &typ, &notNull, &dflt, &pk); err != nil {
+			return err
+		}
+		if pk > 0 {
+			pkCount++
+		}
+		columns = append(columns, Column{Name: name, Type: typ, Nullable: notNull == 0 && pk == 0, PrimaryKey: pk > 0})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range columns {
+		// A single INTEGER PRIMARY KEY column is an alias of the rowid and filled by sqlite.
+		if columns[i].PrimaryKey && pkCount == 1 && strings.EqualFold(columns[i].Type, "INTEGER") {
+			columns[i].AutoIncrement = true
+		}
+		byName[columns[i].Name] = &columns[i]
+	}
+
+	var uniqueIndexes []string
+	err = queryRows(db, fmt.Sprintf("PRAGMA index_list(%s)", quoteSQLite(table)), func(rows *sql.Rows) error {
+		values, err := scanAll(rows)
+		if err != nil {
+			return err
+		}
+		// seq, name, unique, origin, partial
+		if len(values) >= 3 && fmt.Sprint(values[2]) == "1" {
+			uniqueIndexes = append(uniqueIndexes, fmt.Sprint(values[1]))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range uniqueIndexes {
+		var indexed []string
+		err = queryRows(db, fmt.Sprintf("PRAGMA index_info(%s)", quoteSQLite(index)), func(rows *sql.Rows) error {
+			var seqno, cid int
+			var name string
+			if err := rows.Scan(&seqno, &cid, &name); err != nil {
+				return err
+			}
+			indexed = append(indexed, name)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Only single column unique indexes constrain a single generated value.
+		if len(indexed) == 1 && byName[indexed[0]] != nil {
+			byName[indexed[0]].Unique = true
+		}
+	}
+
+	err = queryRows(db, fmt.Sprintf("PRAGMA foreign_key_list(%s)", quoteSQLite(table)), func(rows *sql.Rows) error {
+		values, err := scanAll(rows)
+		if err != nil {
+			return err
+		}
+		// id, seq, table, from, to, on_update, on_delete, match
+		if column := byName[fmt.Sprint(values[3])]; column != nil {
+			column.RefTable = fmt.Sprint(values[2])
+			column.RefColumn = "id"
+			if values[4] != nil {
+				column.RefColumn = fmt.Sprint(values[4])
+			}
+		}
+		return nil
+	})
+	return columns, err
+}
+
+func inspectPostgres(db *sql.DB, table string) ([]Column, error) {
+	var columns []Column
+	byName := make(map[string]*Column)
+	err := queryRows(db, `SELECT column_name, data_type, is_nullable = 'YES', COALESCE(column_default, ''), is_identity = 'YES'
+FROM information_schema.columns
+WHERE table_schema = current_schema() AND table_name = $1
+ORDER BY ordinal_position`, func(rows *sql.Rows) error {
+		var column Column
+		var dflt string
+		var identity bool
+		if err := rows.Scan(&column.Name, &column.Type, &column.Nullable, &dflt, &identity); err != nil {
+			return err
+		}
+		column.AutoIncrement = identity || strings.HasPrefix(dflt, "nextval(")
+		columns = append(columns, column)
+		return nil
+	}, table)
+	if err != nil {
+		return nil, err
+	}
+	for i := range columns {
+		byName[columns[i].Name] = &columns[i]
+	}
+
+	err = queryRows(db, `SELECT kcu.column_name, tc.constraint_type, COALESCE(ccu.table_name, ''), COALESCE(ccu.column_name, ''),
+    (SELECT COUNT(*) FROM information_schema.key_column_usage k WHERE k.constraint_name = tc.constraint_name AND k.table_schema = tc.table_schema)
+FROM information_schema.table_constraints tc
+JOIN information_schema.key_column_usage kcu ON kcu.constraint_name = tc.constraint_name AND kcu.table_schema = tc.table_schema
+LEFT JOIN information_schema.constraint_column_usage ccu ON tc.constraint_type = 'FOREIGN KEY' AND ccu.constraint_name = tc.constraint_name AND ccu.table_schema = tc.table_schema
+WHERE tc.table_schema = current_schema() AND tc.table_name = $1`, func(rows *sql.Rows) error {
+		var name, kind, refTable, refColumn string
+		var size int
+		if err := rows.Scan(&name, &kind, &refTable, &refColumn, &size); err != nil {
+			return err
+		}
+		column := byName[name]
+		if column == nil {
+			return nil
+		}
+		switch kind {
+		case "PRIMARY KEY":
+			column.PrimaryKey = true
+		case "UNIQUE":
+			column.Unique = column.Unique || size == 1
+		case "FOREIGN KEY":
+			column.RefTable, column.RefColumn = refTable, refColumn
+		}
+		return nil
+	}, table)
+	return columns, err
+}
+
+func inspectMySQL(db *sql.DB, table string) ([]Column, error) {
+	var columns []Column
+	byName := make(map[string]*Column)
+	err := queryRows(db, `SELECT column_name, data_type, is_nullable = 'YES', column_key, extra
+FROM information_schema.columns
+WHERE table_schema = DATABASE() AND table_name = ?
+ORDER BY ordinal_position`, func(rows *sql.Rows) error {
+		var column Column
+		var key, extra string
+		if err := rows.Scan(&column.Name, &column.Type, &column.Nullable, &key, &extra); err != nil {
+			return err
+		}
+		column.PrimaryKey = key == "PRI"
+		column.Unique = key == "UNI"
+		column.AutoIncrement = strings.Contains(extra, "auto_increment")
+		columns = append(columns, column)
+		return nil
+	}, table)
+	if err != nil {
+		return nil, err
+	}
+	for i := range columns {
+		byName[columns[i].Name] = &columns[i]
+	}
+
+	err = queryRows(db, `SELECT column_name, referenced_table_name, referenced_column_name
+FROM information_schema.key_column_usage
+WHERE table_schema = DATABASE() AND table_name = ? AND referenced_table_name IS NOT NULL`, func(rows *sql.Rows) error {
+		var name, refTable, refColumn string
+		if err := rows.Scan(&name, &refTable, &refColumn); err != nil {
+			return err
+		}
+		if column := byName[name]; column != nil {
+			column.RefTable, column.RefColumn = refTable, refColumn
+		}
+		return nil
+	}, table)
+	return columns, err
+}
+
+// ModelColumns reads the table name, column mappings and field types of a generated model file,
+// see codegen/model. Fields left out of ColumnMappings (the id) are not returned.
+func ModelColumns(file string) (string, []Column, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, 0)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fieldTypes := make(map[string]string)
+	var table string
+	var mappings [][2]string
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.TypeSpec:
+			if st, ok := node.Type.(*ast.StructType); ok {
+				for _, field := range st.Fields.List {
+					for _, name := range field.Names {
+						fieldTypes[name.Name] = exprString(field.Type)
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			switch node.Name.Name {
+			case "TableName":
+				ast.Inspect(node.Body, func(n ast.Node) bool {
+					if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING && table == "" {
+						table, _ = strconv.Unquote(lit.Value)
+					}
+					return true
+				})
+			case "ColumnMappings":
+				ast.Inspect(node.Body, func(n ast.Node) bool {
+					if kv, ok := n.(*ast.KeyValueExpr); ok {
+						key, kOk := kv.Key.(*ast.BasicLit)
+						value, vOk := kv.Value.(*ast.BasicLit)
+						if kOk && vOk {
+							k, _ := strconv.Unquote(key.Value)
+							v, _ := strconv.Unquote(value.Value)
+							mappings = append(mappings, [2]string{k, v})
+						}
+					}
+					return true
+				})
+			}
+		}
+		return true
+	})
+
+	if table == "" || len(mappings) == 0 {
+		return "", nil, fmt.Errorf(">>Gost>> %s does not look like a gost model, TableName or ColumnMappings is missing", file)
+	}
+
+	columns := make([]Column, 0, len(mappings))
+	for _, mapping := range mappings {
+		goType := fieldTypes[mapping[0]]
+		columns = append(columns, Column{
+			Name:     mapping[1],
+			Type:     strings.TrimPrefix(goType, "*"),
+			Nullable: strings.HasPrefix(goType, "*"),
+		})
+	}
+	return table, columns, nil
+}
+
+// mergeConstraints copies unique and foreign key information from the schema onto model columns.
+func mergeConstraints(columns, schema []Column) []Column {
+	byName := make(map[string]Column, len(schema))
+	for _, column := range schema {
+		byName[column.Name] = column
+	}
+	for i, column := range columns {
+		if s, ok := byName[column.Name]; ok {
+			columns[i].Unique = s.Unique
+			columns[i].RefTable = s.RefTable
+			columns[i].RefColumn = s.RefColumn
+		}
+	}
+	return columns
+}
+
+func exprString(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return "*" + exprString(e.X)
+	case *ast.SelectorExpr:
+		return exprString(e.X) + "." + e.Sel.Name
+	case *ast.ArrayType:
+		return "[]" + exprString(e.Elt)
+	default:
+		return ""
+	}
+}
+
+func queryRows(db *sql.DB, query string, fn func(rows *sql.Rows) error, args ...interface{}) error {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			fmt.Println(">>Gost>> Error closing rows:", err)
+		}
+	}(rows)
+
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// scanAll scans a row of unknown width, PRAGMA results differ in width between sqlite versions.
+func scanAll(rows *sql.Rows) ([]interface{}, error) {
+	names, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(names))
+	ptrs := make([]interface{}, len(names))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	if err := rows.Scan(ptrs...); err != nil {
+		return nil, err
+	}
+	for i, v := range values {
+		if b, ok := v.([]byte); ok {
+			values[i] = string(b)
+		}
+	}
+	return values, nil
+}
+
+func quoteSQLite(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -2,29 +2,39 @@ package helpers
 
 import (
 	"bufio"
+	"database/sql"
 	"fmt"
 	"log"
 	"os"
 	"os/exec"
-	"os/user"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"text/tabwriter"
 	"time"
 	"unicode"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/theHamdiz/gost/cfg"
 	_ "github.com/theHamdiz/gost/cli"
 	"github.com/theHamdiz/gost/clr"
 	"github.com/theHamdiz/gost/codegen"
 	"github.com/theHamdiz/gost/codegen/dirs"
 	"github.com/theHamdiz/gost/codegen/fingerprint"
+	"github.com/theHamdiz/gost/codegen/general"
+	"github.com/theHamdiz/gost/codegen/model"
 	genCfg "github.com/theHamdiz/gost/config"
 	"github.com/theHamdiz/gost/dwn"
+	"github.com/theHamdiz/gost/faker"
 	"github.com/theHamdiz/gost/git"
+	"github.com/theHamdiz/gost/inflect"
+	"github.com/theHamdiz/gost/migrator"
 	"github.com/theHamdiz/gost/npm"
+	"github.com/theHamdiz/gost/plugins/store"
+	"github.com/theHamdiz/gost/project"
 	"github.com/theHamdiz/gost/router"
 	"github.com/theHamdiz/gost/runner"
 	"github.com/theHamdiz/gost/seeder"
@@ -44,7 +54,14 @@ type EnvChecker interface {
 var Config *cfg.GostConfig
 var ProjectData *genCfg.ProjectData
 
-func BuildConfig(config *cfg.GostConfig) {
+// BuildConfig completes config from the saved global config, the given answers and, for anything still
+// missing, the interactive prompts. With yes set the prompts are skipped and their first choice is used.
+// Answers only apply to the current run, prompted values are saved to the global config.
+func BuildConfig(config *cfg.GostConfig, answers cfg.Answers, yes bool) error {
+	if err := answers.Validate(); err != nil {
+		return err
+	}
+
 	scanner := bufio.NewScanner(os.Stdin)
 	existingConfig := isFirstRun()
 	var somethingChanged bool
@@ -56,168 +73,156 @@ func BuildConfig(config *cfg.GostConfig) {
 		fmt.Println(clr.Colorize("[✔] Welcome back to GoSt!", "green"))
 		if config.GlobalSettings == "No set it & forget it." {
 			fmt.Println(clr.Colorize("[✔] Configuration loaded.", "green"))
-			return
+			applyAnswers(config, answers)
+			return nil
 		}
 	} else {
 		somethingChanged = true
 		fmt.Println(clr.Colorize("[✔] Welcome to GoSt! Your favorite go starter tool.", "green"))
 	}
-	if config.PreferredIDE == "" {
-		config.PreferredIDE = askChoice(scanner, "[-] Your IDE of choice:", []string{"VSCode", "Goland", "IDEA", "Cursor", "Zed", "Sublime", "Vim", "Nvim", "Nano", "Notepad++", "Zeus", "LiteIDE", "Emacs", "Eclipse"})
+
+	var askErr error
+	ask := func(question string, choices []string) string {
+		if askErr != nil {
+			return ""
+		}
 		somethingChanged = true
+		if yes {
+			return choices[0]
+		}
+		var choice string
+		choice, askErr = askChoice(scanner, question, choices)
+		return choice
 	}
-	if config.PreferredBackendFramework == "" {
-		config.PreferredBackendFramework = askChoice(scanner, "[-] Choose your backend framework:", []string{"Gin", "Chi", "Echo", "StdLib"})
+	askInt := func(question string, choices []int) int {
+		if askErr != nil {
+			return 0
+		}
 		somethingChanged = true
+		if yes {
+			return choices[0]
+		}
+		var choice int
+		choice, askErr = askIntChoice(scanner, question, choices)
+		return choice
 	}
-	if config.PreferredDbDriver == "" {
-		config.PreferredDbDriver = askChoice(scanner, "[-] Choose your preferred db driver:", []string{"Sqlite", "Postgresql", "MySql", "MongoDb"})
-		somethingChanged = true
+
+	if config.PreferredIDE == "" && answers.IDE == "" {
+		config.PreferredIDE = ask("[-] Your IDE of choice:", cfg.IDEs)
 	}
-	if config.PreferredDbOrm == "" {
-		config.PreferredDbOrm = askChoice(scanner, "[-] Choose your preferred ORM:", []string{"Built In", "Ent", "Gorm", "Bun", "Sqlc", "Bob"})
-		somethingChanged = true
+	if config.PreferredBackendFramework == "" && answers.Backend == "" {
+		config.PreferredBackendFramework = ask("[-] Choose your backend framework:", cfg.BackendFrameworks)
 	}
-	if config.PreferredFrontEndFramework == "" {
-		config.PreferredUiFramework = askChoice(scanner, "[-] Choose your preferred frontend framework:", []string{"Htmx", "React", "Svelte", "Vue"})
-		somethingChanged = true
+	if config.PreferredDbDriver == "" && answers.Db == "" {
+		config.PreferredDbDriver = ask("[-] Choose your preferred db driver:", cfg.DbDrivers)
 	}
-	if config.PreferredUiFramework == "" {
-		config.PreferredUiFramework = askChoice(scanner, "[-] Choose your preferred UI framework:", []string{"Tailwindcss", "Bootstrap"})
-		somethingChanged = true
+	if config.PreferredDbOrm == "" && answers.Orm == "" {
+		config.PreferredDbOrm = ask("[-] Choose your preferred ORM:", cfg.DbOrms)
 	}
-	if config.PreferredUiFramework == "Tailwindcss" && config.PreferredComponentsFramework == "" {
-		config.PreferredComponentsFramework = askChoice(scanner, "[-] Choose your preferred components framework (tailwind only):", []string{"None", "DaisyUI", "Flowbite", "PrelineUI", "TW-Elements"})
-		somethingChanged = true
-	} else if config.PreferredUiFramework != "Tailwindcss" {
+	if config.PreferredFrontEndFramework == "" && answers.Frontend == "" {
+		config.PreferredFrontEndFramework = ask("[-] Choose your preferred frontend framework:", cfg.FrontEndFrameworks)
+	}
+	if config.PreferredUiFramework == "" && answers.Ui == "" {
+		config.PreferredUiFramework = ask("[-] Choose your preferred UI framework:", cfg.UiFrameworks)
+	}
+	if config.PreferredUiFramework == "Tailwindcss" && config.PreferredComponentsFramework == "" && answers.Components == "" {
+		config.PreferredComponentsFramework = ask("[-] Choose your preferred components framework (tailwind only):", cfg.ComponentsFrameworks)
+	} else if config.PreferredUiFramework != "Tailwindcss" && config.PreferredComponentsFramework != "None" {
 		config.PreferredComponentsFramework = "None"
 		somethingChanged = true
 	}
-	if config.PreferredPort == 0 {
-		config.PreferredPort = askIntChoice(scanner, "[-] Preferred Port:", []int{9630, 42069, 6666, 8080})
-		somethingChanged = true
+	if config.PreferredPort == 0 && answers.Port == 0 {
+		config.PreferredPort = askInt("[-] Preferred Port:", cfg.Ports)
 	}
-	if config.GlobalSettings == "" {
-		config.GlobalSettings = askChoice(scanner, "[-] Should we ask you every time you initiate a project or do you want to set your preferences globally?", []string{"Yes ask me", "No set it & forget it.", "Keep IDE settings only global.", "Keep IDE & port settings only global."})
-		somethingChanged = true
+	if config.GlobalSettings == "" && answers.GlobalSettings == "" {
+		config.GlobalSettings = ask("[-] Should we ask you every time you initiate a project or do you want to set your preferences globally?", cfg.GlobalSettingsModes)
 	}
-	if config.PreferredConfigFormat == "" {
-		config.PreferredConfigFormat = askChoice(scanner, "[-] Preferred cfg file format:", []string{"env", "json", "toml", "yaml"})
-		somethingChanged = true
+	if config.PreferredConfigFormat == "" && answers.ConfigFormat == "" {
+		config.PreferredConfigFormat = ask("[-] Preferred cfg file format:", cfg.ConfigFormats)
+	}
+	if askErr != nil {
+		return askErr
 	}
 
 	if somethingChanged {
 		saveConfig(*config)
 	}
+	applyAnswers(config, answers)
+	return nil
 }
 
-func askChoice(scanner *bufio.Scanner, question string, choices []string) string {
+// applyAnswers overrides config with answers, components frameworks only exist for tailwind.
+func applyAnswers(config *cfg.GostConfig, answers cfg.Answers) {
+	answers.Apply(config)
+	if config.PreferredUiFramework != "Tailwindcss" || config.PreferredComponentsFramework == "" {
+		config.PreferredComponentsFramework = "None"
+	}
+}
+
+func askChoice(scanner *bufio.Scanner, question string, choices []string) (string, error) {
 	fmt.Println(clr.Colorize(question, "black"))
 	for i, choice := range choices {
 		fmt.Printf("%d - %s\n", i+1, clr.Colorize(choice, "black"))
 	}
 	for {
 		fmt.Print("> ")
-		scanner.Scan()
+		if !scanner.Scan() {
+			return "", errNoInput(scanner)
+		}
 		input := scanner.Text()
 		if choice, err := strconv.Atoi(input); err == nil && choice >= 1 && choice <= len(choices) {
-			return choices[choice-1]
+			return choices[choice-1], nil
 		}
 		fmt.Println(clr.Colorize("Invalid choice, please try again.", "black"))
 	}
 }
 
-func askIntChoice(scanner *bufio.Scanner, question string, choices []int) int {
+func askIntChoice(scanner *bufio.Scanner, question string, choices []int) (int, error) {
 	fmt.Println(clr.Colorize(question, "black"))
 	for i, choice := range choices {
 		fmt.Printf("%d - %d\n", i+1, choice)
 	}
 	for {
 		fmt.Print("> ")
-		scanner.Scan()
+		if !scanner.Scan() {
+			return 0, errNoInput(scanner)
+		}
 		input := scanner.Text()
 		if choice, err := strconv.Atoi(input); err == nil && choice >= 1 && choice <= len(choices) {
-			return choices[choice-1]
+			return choices[choice-1], nil
 		}
 		fmt.Println(clr.Colorize("Invalid choice, please try again.", "black"))
 	}
 }
 
-func saveConfig(config cfg.GostConfig) {
-	usr, err := user.Current()
-	if err != nil {
-		fmt.Println(clr.Colorize("Error getting user home directory", "red"))
-		return
+// errNoInput explains how to answer the prompts when stdin is closed, e.g. in CI.
+func errNoInput(scanner *bufio.Scanner) error {
+	if err := scanner.Err(); err != nil {
+		return err
 	}
+	return fmt.Errorf(">>Gost>> no input to answer the prompts, pass the answers as flags, use --answers <file> or --yes to accept the defaults")
+}
 
-	var filePath string
-	switch config.PreferredConfigFormat {
-	case "env":
-		filePath = filepath.Join(usr.HomeDir, ".gost.env")
-	case "json":
-		filePath = filepath.Join(usr.HomeDir, ".gost.json")
-	case "toml":
-		filePath = filepath.Join(usr.HomeDir, ".gost.toml")
-	case "yaml":
-		filePath = filepath.Join(usr.HomeDir, ".gost.yaml")
-	default:
-		fmt.Println(clr.Colorize("Invalid cfg format", "red"))
+func saveConfig(config cfg.GostConfig) {
+	filePath, err := cfg.SaveConfig(&config)
+	if err != nil {
+		fmt.Println(clr.Colorize("Error saving cfg: "+err.Error(), "red"))
 		return
 	}
-
-	var saveErr error
-	switch config.PreferredConfigFormat {
-	case "env":
-		saveErr = config.SaveAsEnv(filePath)
-	case "json":
-		saveErr = config.SaveAsJSON(filePath)
-	case "toml":
-		saveErr = config.SaveAsTOML(filePath)
-	case "yaml":
-		saveErr = config.SaveAsYAML(filePath)
-	}
-
-	if saveErr != nil {
-		fmt.Println(clr.Colorize("Error saving cfg: "+saveErr.Error(), "red"))
-	} else {
-		fmt.Println(clr.Colorize("Configuration saved to 👉 "+filePath, "green"))
-	}
+	fmt.Println(clr.Colorize("Configuration saved to 👉 "+filePath, "green"))
 }
 
 func isFirstRun() *cfg.GostConfig {
-	usr, err := user.Current()
-	if err != nil {
-		fmt.Println(clr.Colorize("Error getting user home directory", "red"))
+	configPath := cfg.GetConfigPath()
+	if configPath == "" {
 		return nil
 	}
-
-	envFilePath := filepath.Join(usr.HomeDir, ".gost.env")
-	jsonFilePath := filepath.Join(usr.HomeDir, ".gost.json")
-	tomlFilePath := filepath.Join(usr.HomeDir, ".gost.toml")
-
-	if _, err := os.Stat(envFilePath); err == nil {
-		config, err := cfg.LoadFromEnv(envFilePath)
-		if err == nil {
-			return config
-		}
-		fmt.Println(clr.Colorize("Error loading cfg from .gost.env file", "red"))
-	}
-	if _, err := os.Stat(jsonFilePath); err == nil {
-		config, err := cfg.LoadFromJSON(jsonFilePath)
-		if err == nil {
-			return config
-		}
-		fmt.Println(clr.Colorize("Error loading cfg from .gost.json file", "red"))
-	}
-	if _, err := os.Stat(tomlFilePath); err == nil {
-		config, err := cfg.LoadFromTOML(tomlFilePath)
-		if err == nil {
-			return config
-		}
-		fmt.Println(clr.Colorize("Error loading cfg from .gost.toml file", "red"))
+	config, err := cfg.LoadConfig(configPath)
+	if err != nil {
+		fmt.Println(clr.Colorize("Error loading cfg from "+configPath+": "+err.Error(), "red"))
+		return nil
 	}
-
-	return nil
+	return config
 }
 
 func installFrameworks(projectDir string) error {
@@ -303,7 +308,9 @@ func AddCommands(config cfg.GostConfig) *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) > 0 {
 				config.AppName = args[0]
-				BuildConfig(&config)
+				if err := BuildConfig(&config, cfg.Answers{}, false); err != nil {
+					fmt.Println(clr.Colorize(err.Error(), "red"))
+				}
 			} else {
 				fmt.Println(clr.Colorize("Please specify a command or an app name.", "red"))
 			}
@@ -321,6 +328,7 @@ func AddCommands(config cfg.GostConfig) *cobra.Command {
 	addConfigCommands(rootCmd)
 	addGenerateCommands(rootCmd)
 	addPluginCommands(rootCmd)
+	addTemplateCommands(rootCmd)
 	addTestCommands(rootCmd)
 
 	// Add your existing commands
@@ -329,12 +337,17 @@ func AddCommands(config cfg.GostConfig) *cobra.Command {
 	return rootCmd
 }
 
-func GenerateProjectDir(config *cfg.GostConfig) error {
+// GenerateProjectDir prepares the project data and creates the project dir, a dry run only checks it could be created.
+func GenerateProjectDir(config *cfg.GostConfig, without []string, opts codegen.Options) error {
 	ProjectData = NewProjectDataFromConfig(config)
+	if opts.Reproducible {
+		codegen.Reproducible(ProjectData)
+	}
 	ProjectData.AppName = strings.ToLower(config.AppName)
 	if ProjectData.AppName == "" {
 		panic(clr.Colorize("Please specify a project name!", "red"))
 	}
+	ProjectData.Without = without
 
 	projectDir, err := router.GetProjectPath(ProjectData.AppName)
 	if err != nil {
@@ -347,7 +360,16 @@ func GenerateProjectDir(config *cfg.GostConfig) error {
 		return fmt.Errorf(">>Gost>> Project already exists")
 	}
 
+	if _, err := codegen.LoadSettings(ProjectData); err != nil {
+		return err
+	}
+	if opts.DryRun {
+		return nil
+	}
 	dirsGenerator := dirs.NewDirsGenerator()
+	dirsGenerator.Dirs = slices.DeleteFunc(dirsGenerator.Dirs, func(dir string) bool {
+		return general.Skipped(*ProjectData, dir)
+	})
 	err = dirsGenerator.Generate(projectDir)
 	if err != nil {
 		panic(err)
@@ -358,7 +380,8 @@ func GenerateProjectDir(config *cfg.GostConfig) error {
 }
 
 func initCmd(config cfg.GostConfig) *cobra.Command {
-	return &cobra.Command{
+	var flags creationFlags
+	cmd := &cobra.Command{
 		Use:     "init [app name]",
 		Short:   "Initialize a new project",
 		Args:    cobra.MaximumNArgs(1),
@@ -367,19 +390,30 @@ func initCmd(config cfg.GostConfig) *cobra.Command {
 			if len(args) > 0 {
 				config.AppName = args[0]
 			}
-			BuildConfig(&config)
-			err := GenerateProjectDir(&config)
+			answers, err := flags.resolve()
+			if err == nil {
+				err = BuildConfig(&config, answers, flags.yes)
+			}
 			if err != nil {
 				fmt.Println(clr.Colorize(err.Error(), "red"))
 				return
 			}
-			generateProject(&config)
+			opts := flags.options()
+			err = GenerateProjectDir(&config, flags.without, opts)
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			generateProject(&config, opts)
 		},
 	}
+	flags.register(cmd)
+	return cmd
 }
 
 func createCmd(config cfg.GostConfig) *cobra.Command {
-	return &cobra.Command{
+	var flags creationFlags
+	cmd := &cobra.Command{
 		Use:     "create project [app name]",
 		Short:   "Create a new project",
 		Long:    "Create a new go web project",
@@ -391,19 +425,30 @@ func createCmd(config cfg.GostConfig) *cobra.Command {
 			} else {
 				panic("Please specify a project name")
 			}
-			BuildConfig(&config)
-			err := GenerateProjectDir(&config)
+			answers, err := flags.resolve()
+			if err == nil {
+				err = BuildConfig(&config, answers, flags.yes)
+			}
 			if err != nil {
 				fmt.Println(clr.Colorize(err.Error(), "red"))
 				return
 			}
-			generateProject(&config)
+			opts := flags.options()
+			err = GenerateProjectDir(&config, flags.without, opts)
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			generateProject(&config, opts)
 		},
 	}
+	flags.register(cmd)
+	return cmd
 }
 
 func newCmd(config cfg.GostConfig) *cobra.Command {
-	return &cobra.Command{
+	var flags creationFlags
+	cmd := &cobra.Command{
 		Use:     "new project [app name]",
 		Short:   "Create a new project",
 		Args:    cobra.MaximumNArgs(1),
@@ -412,15 +457,147 @@ func newCmd(config cfg.GostConfig) *cobra.Command {
 			if len(args) > 0 {
 				config.AppName = args[0]
 			}
-			BuildConfig(&config)
-			err := GenerateProjectDir(&config)
+			answers, err := flags.resolve()
+			if err == nil {
+				err = BuildConfig(&config, answers, flags.yes)
+			}
 			if err != nil {
 				fmt.Println(clr.Colorize(err.Error(), "red"))
 				return
 			}
-			generateProject(&config)
+			opts := flags.options()
+			err = GenerateProjectDir(&config, flags.without, opts)
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			generateProject(&config, opts)
 		},
 	}
+	flags.register(cmd)
+	return cmd
+}
+
+// creationFlags answer the project creation prompts of init, create and new from the command line.
+type creationFlags struct {
+	answers     cfg.Answers
+	answersFile string
+	profile     string
+	without     []string
+	yes         bool
+	output      outputFlags
+}
+
+func (f *creationFlags) register(cmd *cobra.Command) {
+	registerAnswerFlags(cmd, &f.answers)
+	cmd.Flags().StringVar(&f.profile, "profile", "", "Config profile to create the project with, see gost config profile")
+	cmd.Flags().StringVar(&f.answersFile, "answers", "", "YAML or JSON file answering the prompts, flags take precedence")
+	cmd.Flags().BoolVarP(&f.yes, "yes", "y", false, "Accept the default of every question left unanswered instead of prompting")
+	cmd.Flags().StringSliceVar(&f.without, "without", nil, "Features to leave out of the project ("+strings.Join(general.FeatureNames(), ", ")+")")
+	f.output.register(cmd.Flags())
+}
+
+func (f *creationFlags) options() codegen.Options {
+	return f.output.options()
+}
+
+// outputFlags control how create and generate write their files: previewed by a dry run, checked to
+// compile with --strict, and what happens to project files changed since they were generated.
+type outputFlags struct {
+	dryRun       bool
+	diff         bool
+	strict       bool
+	reproducible bool
+	onConflict   conflictFlag
+}
+
+func (f *outputFlags) register(flags *pflag.FlagSet) {
+	flags.BoolVar(&f.dryRun, "dry-run", false, "List the files that would be created or modified without writing them")
+	flags.BoolVar(&f.diff, "diff", false, "Show a unified diff of the files that would change, implies --dry-run")
+	flags.BoolVar(&f.strict, "strict", false, "Type-check the generated Go code and write nothing when it does not compile. Uses of third-party packages are only checked when the dependencies of the project are already downloaded")
+	flags.BoolVar(&f.reproducible, "reproducible", false, "Use a fixed clock, SOURCE_DATE_EPOCH if set, and secrets derived from the app name so runs yield identical files")
+	f.onConflict = conflictFlag(general.ConflictSkip)
+	flags.Var(&f.onConflict, "on-conflict", "What to do with files changed since they were generated ("+strings.Join(general.ConflictPolicies, ", ")+")")
+}
+
+func (f *outputFlags) options() codegen.Options {
+	opts := codegen.Options{DryRun: f.dryRun || f.diff, Diff: f.diff, Strict: f.strict, Reproducible: f.reproducible}
+	if policy := general.ConflictPolicy(f.onConflict); policy == general.ConflictPrompt {
+		opts.OnConflict = promptConflicts()
+	} else {
+		opts.OnConflict = general.Resolve(policy)
+	}
+	return opts
+}
+
+// conflictFlag is a conflict policy validated while the flags are parsed.
+type conflictFlag general.ConflictPolicy
+
+func (f *conflictFlag) String() string { return string(*f) }
+func (f *conflictFlag) Type() string   { return "policy" }
+
+func (f *conflictFlag) Set(value string) error {
+	policy, err := general.ParseConflictPolicy(value)
+	if err != nil {
+		return err
+	}
+	*f = conflictFlag(policy)
+	return nil
+}
+
+// promptConflicts asks which policy to apply to every file changed since it was generated.
+func promptConflicts() general.ConflictResolver {
+	scanner := bufio.NewScanner(os.Stdin)
+	choices := []string{string(general.ConflictSkip), string(general.ConflictOverwrite), string(general.ConflictNew)}
+	return func(path string) (general.ConflictPolicy, error) {
+		question := fmt.Sprintf("%s was changed since it was generated: skip keeps it, overwrite replaces it, new writes %s%s next to it", path, path, general.NewFileSuffix)
+		choice, err := askChoice(scanner, question, choices)
+		if err != nil && scanner.Err() != nil {
+			return "", err
+		}
+		if err != nil {
+			return "", fmt.Errorf(">>Gost>> no input to resolve the conflict on %s, pass --on-conflict %s", path, strings.Join(choices, ", "))
+		}
+		return general.ConflictPolicy(choice), nil
+	}
+}
+
+// resolve layers the profile, the answers file and the flags, later ones win.
+// Anything left unanswered falls back to the default profile or the prompts.
+func (f *creationFlags) resolve() (cfg.Answers, error) {
+	var answers cfg.Answers
+	if f.profile != "" {
+		config, _, err := loadGlobalConfig()
+		if err != nil {
+			return answers, err
+		}
+		if answers, err = config.Profile(f.profile); err != nil {
+			return answers, err
+		}
+	}
+	if f.answersFile != "" {
+		fileAnswers, err := cfg.LoadAnswers(f.answersFile)
+		if err != nil {
+			return answers, err
+		}
+		answers = answers.Merge(fileAnswers)
+	}
+	return answers.Merge(f.answers), nil
+}
+
+// registerAnswerFlags adds a flag for every project creation prompt.
+func registerAnswerFlags(cmd *cobra.Command, answers *cfg.Answers) {
+	flags := cmd.Flags()
+	flags.StringVar(&answers.IDE, "ide", "", "IDE of choice ("+strings.Join(cfg.IDEs, ", ")+")")
+	flags.StringVar(&answers.Backend, "backend", "", "Backend framework ("+strings.Join(cfg.BackendFrameworks, ", ")+")")
+	flags.StringVar(&answers.Db, "db", "", "Database driver ("+strings.Join(cfg.DbDrivers, ", ")+")")
+	flags.StringVar(&answers.Orm, "orm", "", "ORM ("+strings.Join(cfg.DbOrms, ", ")+")")
+	flags.StringVar(&answers.Frontend, "frontend", "", "Frontend framework ("+strings.Join(cfg.FrontEndFrameworks, ", ")+")")
+	flags.StringVar(&answers.Ui, "ui", "", "UI framework ("+strings.Join(cfg.UiFrameworks, ", ")+")")
+	flags.StringVar(&answers.Components, "components", "", "Components framework, tailwind only ("+strings.Join(cfg.ComponentsFrameworks, ", ")+")")
+	flags.IntVar(&answers.Port, "port", 0, "Port the app listens on")
+	flags.StringVar(&answers.GlobalSettings, "global-settings", "", "Whether to ask again next time ("+strings.Join(cfg.GlobalSettingsModes, ", ")+")")
+	flags.StringVar(&answers.ConfigFormat, "config-format", "", "Config file format ("+strings.Join(cfg.ConfigFormats, ", ")+")")
 }
 
 func addDbCommands(rootCmd *cobra.Command) {
@@ -430,43 +607,201 @@ func addDbCommands(rootCmd *cobra.Command) {
 		Aliases: []string{"d"},
 	}
 
+	var migrateTo int64
 	var migrateCmd = &cobra.Command{
 		Use:     "migrate",
 		Short:   "Run database migrations",
 		Aliases: []string{"m"},
 		Run: func(cmd *cobra.Command, args []string) {
-			// Call migrator.MigrateDB
 			fmt.Println("Running database migrations")
+			err := withMigrator(func(m *migrator.Migrator) error {
+				applied, err := m.Migrate(migrateTo)
+				for _, migration := range applied {
+					fmt.Println(clr.Colorize(fmt.Sprintf("[✔] %d %s", migration.Version, migration.Name), "green"))
+				}
+				return err
+			})
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
 			fmt.Println("Migrations Complete!")
 		},
 	}
+	migrateCmd.Flags().Int64Var(&migrateTo, "to", 0, "Migrate up or down to the given version")
+
+	var statusCmd = &cobra.Command{
+		Use:     "status",
+		Short:   "Show applied and pending migrations",
+		Aliases: []string{"st"},
+		Run: func(cmd *cobra.Command, args []string) {
+			err := withMigrator(func(m *migrator.Migrator) error {
+				statuses, err := m.Status()
+				if err != nil {
+					return err
+				}
+				for _, status := range statuses {
+					if status.Applied {
+						fmt.Println(clr.Colorize(fmt.Sprintf("[✔] %d %s (applied %s)", status.Version, status.Name, status.AppliedAt), "green"))
+					} else {
+						fmt.Println(clr.Colorize(fmt.Sprintf("[ ] %d %s (pending)", status.Version, status.Name), "black"))
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+			}
+		},
+	}
+	migrateCmd.AddCommand(statusCmd)
 
 	var seedCmd = &cobra.Command{
 		Use:     "seed",
 		Short:   "Seed the database",
 		Aliases: []string{"s"},
 		Run: func(cmd *cobra.Command, args []string) {
-			// Call seeder.SeedDBData
 			fmt.Println("Seeding database data")
+			seeded, err := seeder.SeedDBData(".")
+			for _, file := range seeded {
+				fmt.Println(clr.Colorize("[✔] "+filepath.Base(file), "green"))
+			}
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			if len(seeded) == 0 {
+				fmt.Println(clr.Colorize(">>Gost>> No seed files in "+seeder.SeedsDir, "teal"))
+				return
+			}
 			fmt.Println("Seeding Completed Successfully!")
 		},
 	}
 
+	var rollbackSteps int
 	var rollbackCmd = &cobra.Command{
 		Use:     "rollback",
 		Short:   "Rollback database migrations",
 		Aliases: []string{"r"},
 		Run: func(cmd *cobra.Command, args []string) {
-			// Call db.RollbackDB
 			fmt.Println("Rolling back database migrations")
+			err := withMigrator(func(m *migrator.Migrator) error {
+				reverted, err := m.Rollback(rollbackSteps)
+				for _, migration := range reverted {
+					fmt.Println(clr.Colorize(fmt.Sprintf("[✔] reverted %d %s", migration.Version, migration.Name), "green"))
+				}
+				return err
+			})
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
 			fmt.Println("Rollback Completed Successfully!")
 		},
 	}
+	rollbackCmd.Flags().IntVar(&rollbackSteps, "steps", 1, "Number of migrations to roll back")
+
+	var fakeOpts faker.Options
+	var fakeModel string
+	var fakePrint bool
+	var fakeCmd = &cobra.Command{
+		Use:     "fake [table]",
+		Short:   "Fill a table with fake data",
+		Long:    "Fill a table with fake data inferred from its columns, or from a model's ColumnMappings when --model is set.",
+		Aliases: []string{"f"},
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var table string
+			if len(args) > 0 {
+				table = args[0]
+			}
+			if table == "" && fakeModel == "" {
+				fmt.Println(clr.Colorize("Please provide a table name or a --model", "red"))
+				return
+			}
+			if !cmd.Flags().Changed("seed") {
+				fakeOpts.Seed = time.Now().UnixNano()
+			}
+			file, err := resolveModelFile(fakeModel)
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+
+			if fakePrint {
+				columns, rows, err := faker.GetFakeData(".", table, file, fakeOpts)
+				if err != nil {
+					fmt.Println(clr.Colorize(err.Error(), "red"))
+					return
+				}
+				fmt.Println(strings.Join(columns, "\t"))
+				for _, row := range rows {
+					values := make([]string, len(row))
+					for i, value := range row {
+						values[i] = fmt.Sprint(value)
+					}
+					fmt.Println(strings.Join(values, "\t"))
+				}
+				return
+			}
+
+			inserted, err := faker.FakeDBData(".", table, file, fakeOpts)
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			fmt.Println(clr.Colorize(fmt.Sprintf("[✔] inserted %d fake rows (seed %d)", inserted, fakeOpts.Seed), "green"))
+		},
+	}
+	fakeCmd.Flags().IntVarP(&fakeOpts.Count, "count", "n", 10, "Number of rows to insert")
+	fakeCmd.Flags().Int64Var(&fakeOpts.Seed, "seed", 0, "Seed for reproducible data, random when omitted")
+	fakeCmd.Flags().IntVar(&fakeOpts.BatchSize, "batch-size", 100, "Number of rows per INSERT statement")
+	fakeCmd.Flags().StringVarP(&fakeModel, "model", "m", "", "Model name or file to read the columns from")
+	fakeCmd.Flags().BoolVar(&fakePrint, "print", false, "Print the generated rows instead of inserting them")
 
-	dbCmd.AddCommand(migrateCmd, seedCmd, rollbackCmd)
+	dbCmd.AddCommand(migrateCmd, seedCmd, rollbackCmd, fakeCmd)
 	rootCmd.AddCommand(dbCmd)
 }
 
+// withMigrator opens the database of the project in the working directory and hands a migrator over to fn.
+func withMigrator(fn func(m *migrator.Migrator) error) error {
+	data, err := project.Load(".")
+	if err != nil {
+		return err
+	}
+	db, d, err := project.OpenDB(data)
+	if err != nil {
+		return err
+	}
+	defer func(db *sql.DB) {
+		if err := db.Close(); err != nil {
+			fmt.Println(clr.Colorize("Error closing database: "+err.Error(), "red"))
+		}
+	}(db)
+
+	return fn(migrator.NewMigrator(db, d, filepath.Join(data.ProjectDir, data.MigrationsDir)))
+}
+
+// resolveModelFile turns a model name like BlogPost into app/types/models/blog_post.go,
+// paths to existing files are returned as they are.
+func resolveModelFile(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	if _, err := os.Stat(name); err == nil {
+		return name, nil
+	}
+	root, err := project.FindRoot(".")
+	if err != nil {
+		return "", err
+	}
+	file := filepath.Join(root, "app", "types", "models", inflect.Snake(inflect.Singularize(name))+".go")
+	if _, err := os.Stat(file); err != nil {
+		return "", fmt.Errorf(">>Gost>> no model %s found at %s", name, file)
+	}
+	return file, nil
+}
+
 func addServerCommands(rootCmd *cobra.Command) {
 	var serverCmd = &cobra.Command{
 		Use:     "server",
@@ -508,11 +843,18 @@ func addConfigCommands(rootCmd *cobra.Command) {
 	var setCmd = &cobra.Command{
 		Use:     "set <key> <value>",
 		Short:   "Set a configuration value",
+		Long:    "Set a field of the global configuration, e.g. gost config set PreferredBackendFramework chi",
 		Aliases: []string{"s"},
 		Args:    cobra.ExactArgs(2),
 		Run: func(cmd *cobra.Command, args []string) {
-			// Call config.Set
-			fmt.Printf("Setting %s to %s\n", args[0], args[1])
+			err := updateGlobalConfig(func(config *cfg.GostConfig) error {
+				return config.Set(args[0], args[1])
+			})
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			fmt.Println(clr.Colorize(fmt.Sprintf("[✔] %s set to %s", args[0], args[1]), "green"))
 		},
 	}
 
@@ -522,8 +864,15 @@ func addConfigCommands(rootCmd *cobra.Command) {
 		Aliases: []string{"g"},
 		Args:    cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			// Call config.Get
-			fmt.Printf("Value of %s\n", args[0])
+			config, _, err := loadGlobalConfig()
+			if err == nil {
+				var value string
+				if value, err = config.Get(args[0]); err == nil {
+					fmt.Println(value)
+					return
+				}
+			}
+			fmt.Println(clr.Colorize(err.Error(), "red"))
 		},
 	}
 
@@ -533,20 +882,33 @@ func addConfigCommands(rootCmd *cobra.Command) {
 		Aliases: []string{"rm"},
 		Args:    cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			// Call config.Remove
-			fmt.Printf("Removing %s\n", args[0])
+			err := updateGlobalConfig(func(config *cfg.GostConfig) error {
+				return config.Remove(args[0])
+			})
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			fmt.Println(clr.Colorize(fmt.Sprintf("[✔] %s removed", args[0]), "green"))
 		},
 	}
 
+	var showOutput string
 	var showCmd = &cobra.Command{
 		Use:     "show",
 		Short:   "Show configuration",
 		Aliases: []string{"sh"},
 		Run: func(cmd *cobra.Command, args []string) {
-			// Call config.Show
-			cfg.ShowConfig("")
+			config, _, err := loadGlobalConfig()
+			if err == nil {
+				err = cfg.PrintConfig(os.Stdout, config, showOutput)
+			}
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+			}
 		},
 	}
+	showCmd.Flags().StringVarP(&showOutput, "output", "o", "table", "Output format (table, json)")
 
 	var resetCmd = &cobra.Command{
 		Use:     "reset",
@@ -560,16 +922,164 @@ func addConfigCommands(rootCmd *cobra.Command) {
 		},
 	}
 
-	configCmd.AddCommand(setCmd, getCmd, removeCmd, showCmd, resetCmd)
+	var convertTo string
+	var convertCmd = &cobra.Command{
+		Use:     "convert",
+		Short:   "Convert the configuration file to another format",
+		Aliases: []string{"cv"},
+		Run: func(cmd *cobra.Command, args []string) {
+			from, to, err := cfg.Convert(convertTo)
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			fmt.Println(clr.Colorize(fmt.Sprintf("[✔] Converted %s 👉 %s", from, to), "green"))
+		},
+	}
+	convertCmd.Flags().StringVar(&convertTo, "to", "", "Target format ("+strings.Join(cfg.ConfigFormats, ", ")+")")
+	_ = convertCmd.MarkFlagRequired("to")
+
+	configCmd.AddCommand(setCmd, getCmd, removeCmd, showCmd, resetCmd, convertCmd, profileCmd())
 	rootCmd.AddCommand(configCmd)
 }
 
+func profileCmd() *cobra.Command {
+	var profileCmd = &cobra.Command{
+		Use:     "profile",
+		Short:   "Manage configuration profiles",
+		Long:    "Profiles are named sets of preferences, fields a profile leaves unset are inherited from the default profile.",
+		Aliases: []string{"p"},
+	}
+
+	var addAnswers cfg.Answers
+	var addFile string
+	var addCmd = &cobra.Command{
+		Use:     "add <name>",
+		Short:   "Add or update a profile",
+		Example: "gost config profile add api --backend gin --db postgresql",
+		Aliases: []string{"a", "set"},
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			answers := addAnswers
+			if addFile != "" {
+				fileAnswers, err := cfg.LoadAnswers(addFile)
+				if err != nil {
+					fmt.Println(clr.Colorize(err.Error(), "red"))
+					return
+				}
+				answers = fileAnswers.Merge(addAnswers)
+			}
+			err := updateGlobalConfig(func(config *cfg.GostConfig) error {
+				return config.SetProfile(args[0], answers)
+			})
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			fmt.Println(clr.Colorize(fmt.Sprintf("[✔] Profile %s saved", args[0]), "green"))
+		},
+	}
+	registerAnswerFlags(addCmd, &addAnswers)
+	addCmd.Flags().StringVar(&addFile, "answers", "", "YAML or JSON answers file to read the profile from")
+
+	var listCmd = &cobra.Command{
+		Use:     "list",
+		Short:   "List profiles",
+		Aliases: []string{"l", "ls"},
+		Run: func(cmd *cobra.Command, args []string) {
+			config, _, err := loadGlobalConfig()
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			for _, name := range config.ProfileNames() {
+				overrides := "top level config"
+				if name != cfg.DefaultProfile {
+					var entries []string
+					for _, entry := range config.Profiles[name].Entries() {
+						entries = append(entries, entry[0]+"="+entry[1])
+					}
+					overrides = strings.Join(entries, " ")
+				}
+				_, _ = fmt.Fprintf(tw, "%s\t%s\n", name, overrides)
+			}
+			_ = tw.Flush()
+		},
+	}
+
+	var showOutput string
+	var showCmd = &cobra.Command{
+		Use:     "show <name>",
+		Short:   "Show a profile with the values it inherits",
+		Aliases: []string{"sh"},
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			config, _, err := loadGlobalConfig()
+			if err == nil {
+				var resolved *cfg.GostConfig
+				if resolved, err = config.WithProfile(args[0]); err == nil {
+					err = cfg.PrintConfig(os.Stdout, resolved, showOutput)
+				}
+			}
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+			}
+		},
+	}
+	showCmd.Flags().StringVarP(&showOutput, "output", "o", "table", "Output format (table, json)")
+
+	var removeCmd = &cobra.Command{
+		Use:     "remove <name>",
+		Short:   "Delete a profile",
+		Aliases: []string{"rm", "delete"},
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := updateGlobalConfig(func(config *cfg.GostConfig) error {
+				return config.RemoveProfile(args[0])
+			})
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			fmt.Println(clr.Colorize(fmt.Sprintf("[✔] Profile %s removed", args[0]), "green"))
+		},
+	}
+
+	profileCmd.AddCommand(addCmd, listCmd, showCmd, removeCmd)
+	return profileCmd
+}
+
+// loadGlobalConfig loads the saved global config and returns the file it came from.
+func loadGlobalConfig() (*cfg.GostConfig, string, error) {
+	configPath := cfg.GetConfigPath()
+	if configPath == "" {
+		return nil, "", fmt.Errorf(">>Gost>> Could not find a config file. Please run gost init first.")
+	}
+	config, err := cfg.LoadConfig(configPath)
+	return config, configPath, err
+}
+
+// updateGlobalConfig applies fn to the saved global config and writes it back in the same format.
+func updateGlobalConfig(fn func(config *cfg.GostConfig) error) error {
+	config, configPath, err := loadGlobalConfig()
+	if err != nil {
+		return err
+	}
+	if err := fn(config); err != nil {
+		return err
+	}
+	return config.Save(configPath)
+}
+
 func addGenerateCommands(rootCmd *cobra.Command) {
 	var generateCmd = &cobra.Command{
 		Use:     "generate",
 		Short:   "Generate a new component",
 		Aliases: []string{"g", "codegen"},
 	}
+	var output outputFlags
+	output.register(generateCmd.PersistentFlags())
 
 	var modelCmd = &cobra.Command{
 		Use:     "model <name> [fields]",
@@ -577,10 +1087,9 @@ func addGenerateCommands(rootCmd *cobra.Command) {
 		Aliases: []string{"m", "mod", "mdl", "md"},
 		Args:    cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			name := args[0]
-			fields := args[1:]
-			// Call generator.GenerateModel
-			fmt.Printf("Generating model named %s with fields %v\n", name, fields)
+			if err := generateModel(args[0], args[1:], output.options()); err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+			}
 		},
 	}
 
@@ -667,6 +1176,98 @@ func addGenerateCommands(rootCmd *cobra.Command) {
 	rootCmd.AddCommand(generateCmd)
 }
 
+// generateModel writes the model struct and its create table migration into the current project.
+func generateModel(name string, fieldArgs []string, opts codegen.Options) error {
+	data, err := project.Load(".")
+	if err != nil {
+		return err
+	}
+	fields, err := model.ParseFields(fieldArgs)
+	if err != nil {
+		return err
+	}
+
+	m := model.NewModel(name, fields)
+	err = codegen.Run(*data, opts, func(data genCfg.ProjectData) error {
+		generator := model.NewGenModelPlugin(data, m)
+		if err := generator.Init(); err != nil {
+			return err
+		}
+		return generator.Execute()
+	})
+	if err != nil || opts.DryRun {
+		return err
+	}
+
+	fmt.Println(clr.Colorize(fmt.Sprintf("Model %s generated for table %s 👉 %s", m.Name, m.Table, data.ProjectDir), "green"))
+	return nil
+}
+
+func addTemplateCommands(rootCmd *cobra.Command) {
+	var templatesCmd = &cobra.Command{
+		Use:     "templates",
+		Short:   "Template override commands",
+		Aliases: []string{"tpl"},
+	}
+
+	var global, force bool
+	var ejectCmd = &cobra.Command{
+		Use:   "eject <generator>",
+		Short: "Copy the built-in templates of a generator out for editing",
+		Long: "Copy the built-in templates of a generator into .gost/templates/<generator> of the current project, or of your\n" +
+			"home directory with --global. Templates found there are used instead of the built-in ones, the project's first.\n\n" +
+			"Generators: " + strings.Join(codegen.TemplateNames(), ", "),
+		Example: "gost templates eject handlers\ngost templates eject files --global",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			dir, written, kept, err := ejectTemplates(args[0], global, force)
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			for _, path := range written {
+				fmt.Println("  eject  " + path)
+			}
+			for _, path := range kept {
+				fmt.Println("  keep   " + path)
+			}
+			if len(kept) > 0 {
+				fmt.Printf("%d templates were ejected before and kept, use --force to replace them\n", len(kept))
+			}
+			fmt.Println(clr.Colorize(fmt.Sprintf("[✔] Ejected %d templates of %s 👉 %s", len(written), args[0], dir), "green"))
+		},
+	}
+	ejectCmd.Flags().BoolVar(&global, "global", false, "Eject into ~/.gost/templates to override the templates of every project")
+	ejectCmd.Flags().BoolVar(&force, "force", false, "Replace templates that were ejected before")
+
+	templatesCmd.AddCommand(ejectCmd)
+	rootCmd.AddCommand(templatesCmd)
+}
+
+// ejectTemplates copies the built-in templates of generator into the template overrides of the
+// current project or of the user, and returns the directory with the paths written to and kept in it.
+func ejectTemplates(generator string, global, force bool) (dir string, written, kept []string, err error) {
+	data := &genCfg.ProjectData{}
+	root, err := os.UserHomeDir()
+	if !global {
+		data, err = project.Load(".")
+		if err == nil {
+			root = data.ProjectDir
+		}
+	}
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	files, err := codegen.BuiltinTemplates(generator, *data)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	dir = filepath.Join(root, general.TemplatesDir, generator)
+	written, kept, err = general.EjectTemplates(dir, files, force)
+	return dir, written, kept, err
+}
+
 func addPluginCommands(rootCmd *cobra.Command) {
 	var pluginCmd = &cobra.Command{
 		Use:     "plugin",
@@ -675,13 +1276,24 @@ func addPluginCommands(rootCmd *cobra.Command) {
 	}
 
 	var installCmd = &cobra.Command{
-		Use:     "install <plugin_name>",
+		Use:     "install <dir|tarball|git_url>",
 		Short:   "Install a plugin",
+		Long:    "Install a plugin from a local directory, a .tar.gz tarball or a git URL into ~/.gost/plugins.",
+		Example: "gost plugin install ./my-generator\ngost plugin install https://github.com/acme/gost-audit.git",
 		Aliases: []string{"i"},
 		Args:    cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			// Call plugin.Install
-			fmt.Printf("Installing plugin %s\n", args[0])
+			pluginStore, err := store.OpenDefault()
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			entry, err := pluginStore.Install(args[0])
+			if err != nil {
+				fmt.Println(clr.Colorize(fmt.Sprintf(">>Gost>> Could not install %s: %v", args[0], err), "red"))
+				return
+			}
+			fmt.Println(clr.Colorize(fmt.Sprintf("[✔] Installed plugin %s %s", entry.Name, entry.Version), "green"))
 		},
 	}
 
@@ -691,8 +1303,17 @@ func addPluginCommands(rootCmd *cobra.Command) {
 		Aliases: []string{"u"},
 		Args:    cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			// Call plugin.Uninstall
-			fmt.Printf("Uninstalling plugin %s\n", args[0])
+			pluginStore, err := store.OpenDefault()
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			entry, err := pluginStore.Uninstall(args[0])
+			if err != nil {
+				fmt.Println(clr.Colorize(fmt.Sprintf(">>Gost>> %v", err), "red"))
+				return
+			}
+			fmt.Println(clr.Colorize(fmt.Sprintf("[✔] Uninstalled plugin %s %s", entry.Name, entry.Version), "green"))
 		},
 	}
 
@@ -701,8 +1322,30 @@ func addPluginCommands(rootCmd *cobra.Command) {
 		Short:   "List installed plugins",
 		Aliases: []string{"l", "ls"},
 		Run: func(cmd *cobra.Command, args []string) {
-			// Call plugin.List
-			fmt.Println("Listing installed plugins")
+			pluginStore, err := store.OpenDefault()
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			entries, err := pluginStore.List()
+			if err != nil {
+				fmt.Println(clr.Colorize(err.Error(), "red"))
+				return
+			}
+			if len(entries) == 0 {
+				fmt.Println(">>Gost>> No plugins installed, add one with gost plugin install")
+				return
+			}
+			tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(tw, "NAME\tVERSION\tAUTHOR\tDEPENDENCIES\tENABLED")
+			for _, entry := range entries {
+				dependencies := strings.Join(entry.Dependencies, ", ")
+				if dependencies == "" {
+					dependencies = "-"
+				}
+				_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%t\n", entry.Name, entry.Version, entry.AuthorName, dependencies, entry.Enabled)
+			}
+			_ = tw.Flush()
 		},
 	}
 
@@ -766,7 +1409,7 @@ func NewProjectDataFromConfig(config *cfg.GostConfig) *genCfg.ProjectData {
 		BackendPkg:            config.PreferredBackendFramework,
 		ComponentsFramework:   config.PreferredComponentsFramework,
 		PreferredConfigFormat: config.PreferredConfigFormat,
-		ConfigFile:            config.PreferredConfigFormat,
+		ConfigFile:            genCfg.ConfigFileName(config.PreferredConfigFormat),
 		CurrentYear:           time.Now().Year(),
 		DbDriver:              config.PreferredDbDriver,
 		UiFramework:           config.PreferredUiFramework,
@@ -777,7 +1420,7 @@ func NewProjectDataFromConfig(config *cfg.GostConfig) *genCfg.ProjectData {
 	}
 }
 
-func generateProject(config *cfg.GostConfig) {
+func generateProject(config *cfg.GostConfig, opts codegen.Options) {
 	fmt.Println(clr.Colorize("App Name:", "teal"), clr.Colorize(ProjectData.AppName, "green"))
 	fmt.Println(clr.Colorize("Project Directory:", "teal"), clr.Colorize(ProjectData.ProjectDir, "green"))
 	fmt.Println(clr.Colorize("Project Config File:", "teal"), clr.Colorize(ProjectData.ConfigFile, "green"))
@@ -788,8 +1431,11 @@ func generateProject(config *cfg.GostConfig) {
 	fmt.Println(clr.Colorize("DB Driver:", "teal"), clr.Colorize(ProjectData.DbDriver, ""))
 	fmt.Println(clr.Colorize("DB Orm:", "teal"), clr.Colorize(ProjectData.DbOrm, ""))
 
-	if err := codegen.ExecuteGeneration(*ProjectData); err != nil {
-		fmt.Printf("Error generating files: %v\n", err)
+	// A dry run previews the complete generation below only once.
+	if !opts.DryRun {
+		if err := codegen.ExecuteGeneration(*ProjectData, codegen.Options{}); err != nil {
+			fmt.Printf("Error generating files: %v\n", err)
+		}
 	}
 
 	switch strings.ToLower(ProjectData.BackendPkg) {
@@ -810,17 +1456,32 @@ func generateProject(config *cfg.GostConfig) {
 		ProjectData.BackendInit = "echo.New()"
 		ProjectData.VersionedBackendImport = fmt.Sprintf("%s@%s", ProjectData.BackendImport, "v5.0.0-20230722203903-ec5b858dab61")
 		ProjectData.VersionedBackendImport = strings.Replace(ProjectData.VersionedBackendImport, "@", " ", 1)
+	case "fiber":
+		ProjectData.BackendImport = "github.com/gofiber/fiber/v2"
+		ProjectData.BackendPkg = "fiber"
+		ProjectData.BackendInit = "fiber.New()"
+		ProjectData.VersionedBackendImport = fmt.Sprintf("%s@%s", ProjectData.BackendImport, getLatestGoPackageVersion("github.com/gofiber/fiber/v2"))
+		ProjectData.VersionedBackendImport = strings.Replace(ProjectData.VersionedBackendImport, "@", " ", 1)
+	case "stdlib":
+		// http.ServeMux routes by method and path wildcards since Go 1.22, the project needs no router module.
+		ProjectData.BackendImport = "net/http"
+		ProjectData.BackendPkg = "stdlib"
+		ProjectData.BackendInit = "http.NewServeMux()"
+		ProjectData.VersionedBackendImport = ""
 	default:
 		log.Fatalf("Unsupported backend framework: %s", config.PreferredBackendFramework)
 	}
 
-	fingerPrint, _ := fingerprint.Fingerprint(config.AppName)
+	fingerPrint, _ := fingerprint.New(config.AppName, ProjectData.Now(), ProjectData.Random())
 	ProjectData.Fingerprint = fingerPrint
 
-	err := codegen.ExecuteGeneration(*ProjectData)
+	err := codegen.ExecuteGeneration(*ProjectData, opts)
 	if err != nil {
 		log.Fatal(err)
 	}
+	if opts.DryRun {
+		return
+	}
 
 	err = seeder.DbInit(config.AppName)
 	if err != nil {
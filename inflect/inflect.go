@@ -0,0 +1,305 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// initialisms are kept upper-cased when building Go identifiers, e.g. author_id -> AuthorID.
+var initialisms = map[string]bool{
+	"api":  true,
+	"css":  true,
+	"db":   true,
+	"html": true,
+	"http": true,
+	"id":   true,
+	"ip":   true,
+	"json": true,
+	"sql":  true,
+	"uri":  true,
+	"url":  true,
+	"uuid": true,
+}
+
+var irregularPlurals = map[string]string{
+	"child":  "children",
+	"person": "people",
+	"man":    "men",
+	"woman":  "women",
+	"mouse":  "mice",
+	"goose":  "geese",
+	"foot":   "feet",
+	"tooth":  "teeth",
+	"knife":  "knives",
+	"life":   "lives",
+	"wife":   "wives",
+	"leaf":   "leaves",
+	"half":   "halves",
+	"shelf":  "shelves",
+	"wolf":   "wolves",
+}
+
+// ieSingulars are nouns ending in "ie", their plural ends in "ies" like the plural of the nouns ending in "y".
+var ieSingulars = map[string]bool{
+	"auntie":   true,
+	"beanie":   true,
+	"birdie":   true,
+	"bookie":   true,
+	"brownie":  true,
+	"calorie":  true,
+	"collie":   true,
+	"cookie":   true,
+	"foodie":   true,
+	"freebie":  true,
+	"genie":    true,
+	"goalie":   true,
+	"groupie":  true,
+	"hippie":   true,
+	"hoodie":   true,
+	"indie":    true,
+	"lie":      true,
+	"magpie":   true,
+	"movie":    true,
+	"newbie":   true,
+	"pie":      true,
+	"pixie":    true,
+	"prairie":  true,
+	"rookie":   true,
+	"selfie":   true,
+	"smoothie": true,
+	"sortie":   true,
+	"techie":   true,
+	"tie":      true,
+	"veggie":   true,
+	"zombie":   true,
+}
+
+// sSingulars are singular nouns ending in a single "s", their plural adds "es".
+var sSingulars = map[string]bool{
+	"alias":      true,
+	"apparatus":  true,
+	"atlas":      true,
+	"bias":       true,
+	"bonus":      true,
+	"bus":        true,
+	"campus":     true,
+	"canvas":     true,
+	"census":     true,
+	"chorus":     true,
+	"circus":     true,
+	"corpus":     true,
+	"focus":      true,
+	"gas":        true,
+	"genus":      true,
+	"lens":       true,
+	"minus":      true,
+	"nexus":      true,
+	"plus":       true,
+	"prospectus": true,
+	"status":     true,
+	"syllabus":   true,
+	"virus":      true,
+	"walrus":     true,
+}
+
+var uncountables = map[string]bool{
+	"equipment":   true,
+	"information": true,
+	"money":       true,
+	"news":        true,
+	"series":      true,
+	"sheep":       true,
+	"species":     true,
+	"fish":        true,
+	"data":        true,
+	"metadata":    true,
+}
+
+// Words splits an identifier written in any common casing (snake, kebab, camel, pascal or spaced)
+// into its lower-cased words.
+func Words(s string) []string {
+	var words []string
+	var current []rune
+	runes := []rune(s)
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, strings.ToLower(string(current)))
+			current = current[:0]
+		}
+	}
+
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == ' ' || r == '.' || r == '/':
+			flush()
+		case unicode.IsUpper(r):
+			// Start a new word on a lower->upper transition, or at the last upper of an acronym (HTTPServer -> http server).
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				flush()
+			}
+			current = append(current, r)
+		default:
+			current = append(current, r)
+		}
+	}
+	flush()
+	return words
+}
+
+// Snake converts s to snake_case.
+func Snake(s string) string {
+	return strings.Join(Words(s), "_")
+}
+
+// Kebab converts s to kebab-case.
+func Kebab(s string) string {
+	return strings.Join(Words(s), "-")
+}
+
+// Pascal converts s to a PascalCase Go identifier, keeping common initialisms upper-cased.
+func Pascal(s string) string {
+	var b strings.Builder
+	for _, w := range Words(s) {
+		if initialisms[w] {
+			b.WriteString(strings.ToUpper(w))
+			continue
+		}
+		b.WriteString(UpperFirst(w))
+	}
+	return b.String()
+}
+
+// Camel converts s to camelCase without initialism handling, which is what JSON keys usually want.
+func Camel(s string) string {
+	words := Words(s)
+	for i := 1; i < len(words); i++ {
+		words[i] = UpperFirst(words[i])
+	}
+	return strings.Join(words, "")
+}
+
+// UpperFirst upper-cases the first letter of s.
+func UpperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// LowerFirst lower-cases the first letter of s.
+func LowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// Pluralize returns the English plural of the last word in s, preserving the rest of s untouched.
+func Pluralize(s string) string {
+	return inflectLastWord(s, pluralOf)
+}
+
+// Singularize returns the English singular of the last word in s, preserving the rest of s untouched.
+func Singularize(s string) string {
+	return inflectLastWord(s, singularOf)
+}
+
+func inflectLastWord(s string, fn func(string) string) string {
+	if s == "" {
+		return s
+	}
+	// Find where the last word starts so that "blog_post" -> "blog_posts" and "BlogPost" -> "BlogPosts".
+	runes := []rune(s)
+	start := 0
+	for i := len(runes) - 1; i > 0; i-- {
+		if runes[i-1] == '_' || runes[i-1] == '-' || runes[i-1] == ' ' {
+			start = i
+			break
+		}
+		if unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i-1]) {
+			start = i
+			break
+		}
+	}
+	prefix, word := string(runes[:start]), string(runes[start:])
+	inflected := fn(strings.ToLower(word))
+	return prefix + matchCase(word, inflected)
+}
+
+func matchCase(original, inflected string) string {
+	switch {
+	case strings.ToUpper(original) == original && len(original) > 1:
+		return strings.ToUpper(inflected)
+	case unicode.IsUpper([]rune(original)[0]):
+		return UpperFirst(inflected)
+	default:
+		return inflected
+	}
+}
+
+func pluralOf(word string) string {
+	if uncountables[word] {
+		return word
+	}
+	if plural, ok := irregularPlurals[word]; ok {
+		return plural
+	}
+	for _, plural := range irregularPlurals {
+		if plural == word {
+			return word
+		}
+	}
+	switch {
+	case sSingulars[word] || strings.HasSuffix(word, "us"):
+		return word + "es"
+	case strings.HasSuffix(word, "s") || strings.HasSuffix(word, "x") || strings.HasSuffix(word, "z") ||
+		strings.HasSuffix(word, "ch") || strings.HasSuffix(word, "sh"):
+		if strings.HasSuffix(word, "ss") || !strings.HasSuffix(word, "s") {
+			return word + "es"
+		}
+		// Already ends with a single "s", assume it's plural.
+		return word
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !isVowel(rune(word[len(word)-2])):
+		return word[:len(word)-1] + "ies"
+	default:
+		return word + "s"
+	}
+}
+
+func singularOf(word string) string {
+	if uncountables[word] {
+		return word
+	}
+	for singular, plural := range irregularPlurals {
+		if plural == word {
+			return singular
+		}
+	}
+	switch {
+	case ieSingulars[strings.TrimSuffix(word, "s")]:
+		return word[:len(word)-1]
+	case strings.HasSuffix(word, "ses") && sSingulars[word[:len(word)-2]]:
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "ies") && len(word) > 3:
+		return word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "sses") || strings.HasSuffix(word, "xes") || strings.HasSuffix(word, "zes") ||
+		strings.HasSuffix(word, "ches") || strings.HasSuffix(word, "shes"):
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "ss") || strings.HasSuffix(word, "us") || strings.HasSuffix(word, "is"):
+		return word
+	case strings.HasSuffix(word, "s"):
+		return word[:len(word)-1]
+	default:
+		return word
+	}
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aeiou", r)
+}
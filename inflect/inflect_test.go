@@ -0,0 +1,52 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCasing(t *testing.T) {
+	assert.Equal(t, "blog_post", Snake("BlogPost"))
+	assert.Equal(t, "http_server", Snake("HTTPServer"))
+	assert.Equal(t, "blog-post", Kebab("blog_post"))
+	assert.Equal(t, "AuthorID", Pascal("author_id"))
+	assert.Equal(t, "BlogPost", Pascal("blog-post"))
+	assert.Equal(t, "authorId", Camel("author_id"))
+	assert.Equal(t, "blogPost", LowerFirst("BlogPost"))
+}
+
+func TestPluralizeAndSingularize(t *testing.T) {
+	cases := map[string]string{
+		"post":         "posts",
+		"category":     "categories",
+		"box":          "boxes",
+		"person":       "people",
+		"news":         "news",
+		"blog_post":    "blog_posts",
+		"BlogPost":     "BlogPosts",
+		"key":          "keys",
+		"movie":        "movies",
+		"cookie":       "cookies",
+		"user_movie":   "user_movies",
+		"genie":        "genies",
+		"brownie":      "brownies",
+		"pie":          "pies",
+		"status":       "statuses",
+		"bus":          "buses",
+		"campus":       "campuses",
+		"alias":        "aliases",
+		"order_status": "order_statuses",
+		"house":        "houses",
+		"class":        "classes",
+	}
+	for singular, plural := range cases {
+		assert.Equal(t, plural, Pluralize(singular), "Pluralize(%q)", singular)
+		assert.Equal(t, singular, Singularize(plural), "Singularize(%q)", plural)
+	}
+}
+
+func TestPluralizeUnlistedWordsEndingInUs(t *testing.T) {
+	assert.Equal(t, "octopuses", Pluralize("octopus"))
+	assert.Equal(t, "posts", Pluralize("posts"))
+}
@@ -1,5 +1,306 @@
 package migrator
 
-func MigrateDB(appName string) error {
-	return nil
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/theHamdiz/gost/dialect"
+	"github.com/theHamdiz/gost/project"
+)
+
+const (
+	upMarker   = "-- +gost Up"
+	downMarker = "-- +gost Down"
+)
+
+// versionPattern extracts the version from create_db_<nanos>.sql or <version>_<name>.sql file names.
+var versionPattern = regexp.MustCompile(`(?:_(\d+)$)|(?:^(\d+)_)`)
+
+// Migration is a single migration file split into its up and down sections.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is the state of a migration in the database.
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt string
+}
+
+// ParseMigration splits the content of a migration file into its -- +gost Up and -- +gost Down sections.
+// A file without markers is treated as an up only migration.
+func ParseMigration(fileName, content string) (Migration, error) {
+	name := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	m := versionPattern.FindStringSubmatch(name)
+	if m == nil {
+		return Migration{}, fmt.Errorf(">>Gost>> migration %s has no version in its file name", fileName)
+	}
+	raw := m[1]
+	if raw == "" {
+		raw = m[2]
+	}
+	version, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return Migration{}, fmt.Errorf(">>Gost>> migration %s has an invalid version: %w", fileName, err)
+	}
+
+	migration := Migration{Version: version, Name: name}
+	upIdx, downIdx := strings.Index(content, upMarker), strings.Index(content, downMarker)
+	switch {
+	case upIdx == -1 && downIdx == -1:
+		migration.Up = content
+	case upIdx == -1:
+		migration.Up = content[:downIdx]
+		migration.Down = content[downIdx+len(downMarker):]
+	case downIdx == -1:
+		migration.Up = content[upIdx+len(upMarker):]
+	case upIdx < downIdx:
+		migration.Up = content[upIdx+len(upMarker) : downIdx]
+		migration.Down = content[downIdx+len(downMarker):]
+	default:
+		migration.Down = content[downIdx+len(downMarker) : upIdx]
+		migration.Up = content[upIdx+len(upMarker):]
+	}
+	migration.Up = strings.TrimSpace(migration.Up)
+	migration.Down = strings.TrimSpace(migration.Down)
+	return migration, nil
+}
+
+// LoadMigrations reads every .sql migration in dir ordered by version.
+func LoadMigrations(dir string) ([]Migration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []Migration
+	seen := make(map[int64]string)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		migration, err := ParseMigration(entry.Name(), string(content))
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := seen[migration.Version]; ok {
+			return nil, fmt.Errorf(">>Gost>> migrations %s and %s share version %d", other, migration.Name, migration.Version)
+		}
+		seen[migration.Version] = migration.Name
+		migrations = append(migrations, migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Migrator applies and rolls back the migrations of a directory, recording them in schema_migrations.
+type Migrator struct {
+	db      *sql.DB
+	dialect *dialect.Dialect
+	dir     string
+}
+
+func NewMigrator(db *sql.DB, d *dialect.Dialect, dir string) *Migrator {
+	return &Migrator{
+		db:      db,
+		dialect: d,
+		dir:     dir,
+	}
+}
+
+func (m *Migrator) ensureSchemaTable() error {
+	bigint, _ := m.dialect.ColumnType("bigint")
+	name, _ := m.dialect.ColumnType("string")
+	timestamp, _ := m.dialect.ColumnType("time")
+	_, err := m.db.Exec(fmt.Sprintf(`CREATE TABLE IF NOT EXISTS schema_migrations (
+    version %s PRIMARY KEY,
+    name %s NOT NULL,
+    applied_at %s NOT NULL DEFAULT %s
+)`, bigint, name, timestamp, m.dialect.Now))
+	return err
+}
+
+// applied returns the applied versions and when they were applied.
+func (m *Migrator) applied() (map[int64]string, error) {
+	if err := m.ensureSchemaTable(); err != nil {
+		return nil, err
+	}
+	rows, err := m.db.Query("SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			fmt.Println(">>Gost>> Error closing rows:", err)
+		}
+	}(rows)
+
+	applied := make(map[int64]string)
+	for rows.Next() {
+		var version int64
+		var appliedAt string
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// Status lists every migration found on disk with whether it has been applied.
+func (m *Migrator) Status() ([]Status, error) {
+	migrations, err := LoadMigrations(m.dir)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(migrations))
+	for _, migration := range migrations {
+		appliedAt, ok := applied[migration.Version]
+		statuses = append(statuses, Status{Migration: migration, Applied: ok, AppliedAt: appliedAt})
+	}
+	return statuses, nil
+}
+
+// Migrate applies pending migrations up to and including version to, or all of them when to is 0.
+// When to is lower than already applied versions those are rolled back instead.
+func (m *Migrator) Migrate(to int64) ([]Migration, error) {
+	statuses, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+	if to != 0 && !hasVersion(statuses, to) {
+		return nil, fmt.Errorf(">>Gost>> no migration with version %d in %s", to, m.dir)
+	}
+
+	var done []Migration
+	// Roll back anything applied after the target first, newest first.
+	for i := len(statuses) - 1; i >= 0 && to != 0; i-- {
+		if statuses[i].Applied && statuses[i].Version > to {
+			if err := m.down(statuses[i].Migration); err != nil {
+				return done, err
+			}
+			done = append(done, statuses[i].Migration)
+		}
+	}
+	for _, status := range statuses {
+		if status.Applied || (to != 0 && status.Version > to) {
+			continue
+		}
+		if err := m.up(status.Migration); err != nil {
+			return done, err
+		}
+		done = append(done, status.Migration)
+	}
+	return done, nil
+}
+
+// Rollback reverts the last steps applied migrations, newest first.
+func (m *Migrator) Rollback(steps int) ([]Migration, error) {
+	if steps < 1 {
+		return nil, errors.New(">>Gost>> rollback steps must be at least 1")
+	}
+	statuses, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(statuses) - 1; i >= 0 && len(done) < steps; i-- {
+		if !statuses[i].Applied {
+			continue
+		}
+		if err := m.down(statuses[i].Migration); err != nil {
+			return done, err
+		}
+		done = append(done, statuses[i].Migration)
+	}
+	return done, nil
+}
+
+func (m *Migrator) up(migration Migration) error {
+	return m.run(migration, migration.Up, func(tx *sql.Tx) error {
+		_, err := tx.Exec(fmt.Sprintf("INSERT INTO schema_migrations (version, name) VALUES (%s)", m.dialect.Placeholders(2)), migration.Version, migration.Name)
+		return err
+	})
+}
+
+func (m *Migrator) down(migration Migration) error {
+	if migration.Down == "" {
+		return fmt.Errorf(">>Gost>> migration %s has no %q section and cannot be rolled back", migration.Name, downMarker)
+	}
+	return m.run(migration, migration.Down, func(tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM schema_migrations WHERE version = "+m.dialect.Placeholder(1), migration.Version)
+		return err
+	})
+}
+
+// run executes a migration section and its bookkeeping statement in a single transaction.
+func (m *Migrator) run(migration Migration, script string, record func(tx *sql.Tx) error) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+	for i, statement := range m.dialect.SplitStatements(script) {
+		if _, err := tx.Exec(statement); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf(">>Gost>> migration %s failed at statement %d: %w\n%s", migration.Name, i+1, err, statement)
+		}
+	}
+	if err := record(tx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf(">>Gost>> failed to record migration %s: %w", migration.Name, err)
+	}
+	return tx.Commit()
+}
+
+func hasVersion(statuses []Status, version int64) bool {
+	for _, status := range statuses {
+		if status.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrateDB applies every pending migration of the gost project found at projectDir.
+func MigrateDB(projectDir string) error {
+	data, err := project.Load(projectDir)
+	if err != nil {
+		return err
+	}
+	db, d, err := project.OpenDB(data)
+	if err != nil {
+		return err
+	}
+	defer func(db *sql.DB) {
+		if err := db.Close(); err != nil {
+			fmt.Println(">>Gost>> Error closing database:", err)
+		}
+	}(db)
+
+	_, err = NewMigrator(db, d, filepath.Join(data.ProjectDir, data.MigrationsDir)).Migrate(0)
+	return err
 }
@@ -1 +1,64 @@
 package migrator
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theHamdiz/gost/dialect"
+)
+
+func TestParseMigration(t *testing.T) {
+	m, err := ParseMigration("create_posts_42.sql", "-- +gost Up\nCREATE TABLE posts (id INTEGER);\n\n-- +gost Down\nDROP TABLE posts;\n")
+	assert.NoError(t, err)
+	assert.Equal(t, Migration{Version: 42, Name: "create_posts_42", Up: "CREATE TABLE posts (id INTEGER);", Down: "DROP TABLE posts;"}, m)
+
+	m, err = ParseMigration("7_seed.sql", "INSERT INTO settings VALUES (1);")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), m.Version)
+	assert.Equal(t, "INSERT INTO settings VALUES (1);", m.Up)
+	assert.Empty(t, m.Down)
+
+	_, err = ParseMigration("create_posts.sql", "")
+	assert.Error(t, err)
+}
+
+func TestMigrateAndRollback(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	write("create_users_1.sql", "-- +gost Up\nCREATE TABLE users (id INTEGER PRIMARY KEY);\n-- +gost Down\nDROP TABLE users;")
+	write("create_posts_2.sql", "-- +gost Up\nCREATE TABLE posts (id INTEGER PRIMARY KEY);\n-- +gost Down\nDROP TABLE posts;")
+	write("broken_3.sql", "-- +gost Up\nCREATE TABLE tags (id INTEGER PRIMARY KEY);\nTHIS IS NOT SQL;\n-- +gost Down\nDROP TABLE tags;")
+
+	db, err := sql.Open("sqlite", filepath.Join(dir, "data.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	d, _ := dialect.For("sqlite")
+	m := NewMigrator(db, d, dir)
+
+	applied, err := m.Migrate(2)
+	assert.NoError(t, err)
+	assert.Len(t, applied, 2)
+
+	// The broken migration is rolled back as a whole, including its valid first statement.
+	_, err = m.Migrate(0)
+	assert.ErrorContains(t, err, "broken_3 failed at statement 2")
+	var tables int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE name = 'tags'").Scan(&tables))
+	assert.Equal(t, 0, tables)
+
+	reverted, err := m.Rollback(1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), reverted[0].Version)
+
+	statuses, err := m.Status()
+	assert.NoError(t, err)
+	assert.True(t, statuses[0].Applied)
+	assert.False(t, statuses[1].Applied)
+	assert.False(t, statuses[2].Applied)
+}
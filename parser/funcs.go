@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/theHamdiz/gost/inflect"
+)
+
+// Funcs returns the helper functions available to every template, built-in or overridden:
+//
+//	{{pluralize "post"}}            posts
+//	{{singularize "posts"}}         post
+//	{{snake "BlogPost"}}            blog_post
+//	{{kebab "BlogPost"}}            blog-post
+//	{{camel "blog_post"}}           blogPost
+//	{{pascal "user_id"}}            UserID
+//	{{lowerFirst "Post"}}           post
+//	{{quote .AppName}}              "blog"
+//	{{indent 4 .Body}}              every non-empty line of .Body indented by 4 spaces
+//	{{join ", " .Tags}}             the elements of a slice joined by ", "
+//	{{default "8080" .Port}}        .Port, or "8080" when .Port is empty
+//	{{dict "Name" "post" "Id" 1}}   a map to pass several values to a nested template
+//
+// The arguments of join, default and indent are ordered so they can be piped: {{.Tags | join ", "}}.
+func Funcs() map[string]interface{} {
+	return map[string]interface{}{
+		"pluralize":   inflect.Pluralize,
+		"singularize": inflect.Singularize,
+		"snake":       inflect.Snake,
+		"kebab":       inflect.Kebab,
+		"camel":       inflect.Camel,
+		"pascal":      inflect.Pascal,
+		"lowerFirst":  inflect.LowerFirst,
+		"quote":       quote,
+		"indent":      indent,
+		"join":        join,
+		"default":     defaultValue,
+		"dict":        dict,
+	}
+}
+
+func quote(value interface{}) string {
+	return strconv.Quote(fmt.Sprint(value))
+}
+
+// indent pads every line of s with spaces, empty lines are left empty so no trailing whitespace is generated.
+func indent(spaces int, s string) string {
+	pad := strings.Repeat(" ", spaces)
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = pad + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func join(sep string, list interface{}) (string, error) {
+	if list == nil {
+		return "", nil
+	}
+	value := reflect.ValueOf(list)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return "", fmt.Errorf("join expects a list, got %T", list)
+	}
+	items := make([]string, value.Len())
+	for i := range items {
+		items[i] = fmt.Sprint(value.Index(i).Interface())
+	}
+	return strings.Join(items, sep), nil
+}
+
+// defaultValue returns value unless it is empty: nil, false, zero, or an empty string, slice or map.
+func defaultValue(fallback, value interface{}) interface{} {
+	if value == nil {
+		return fallback
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.String:
+		if v.Len() == 0 {
+			return fallback
+		}
+	default:
+		if v.IsZero() {
+			return fallback
+		}
+	}
+	return value
+}
+
+func dict(pairs ...interface{}) (map[string]interface{}, error) {
+	if len(pairs)%2 != 0 {
+		return nil, fmt.Errorf("dict expects key value pairs, got %d arguments", len(pairs))
+	}
+	m := make(map[string]interface{}, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		key, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("dict keys must be strings, got %T", pairs[i])
+		}
+		m[key] = pairs[i+1]
+	}
+	return m, nil
+}
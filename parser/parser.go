@@ -10,7 +10,7 @@ import (
 func ParseTemplateStringAsText(fileName, tmpl string, data interface{}) (string, error) {
 	// Determine whether to use html/template or text/template
 	if strings.HasSuffix(fileName, ".html") || strings.HasSuffix(fileName, ".htm") || strings.HasSuffix(fileName, ".tmpl") || strings.HasSuffix(fileName, ".gohtml") {
-		htmpl, err := ht.New(fileName).Parse(tmpl)
+		htmpl, err := ht.New(fileName).Funcs(Funcs()).Parse(tmpl)
 		if err != nil {
 			return "", err
 		}
@@ -22,7 +22,7 @@ func ParseTemplateStringAsText(fileName, tmpl string, data interface{}) (string,
 
 		return buf.String(), nil
 	} else {
-		ttmpl, err := tt.New(fileName).Parse(tmpl)
+		ttmpl, err := tt.New(fileName).Funcs(Funcs()).Parse(tmpl)
 		if err != nil {
 			return "", err
 		}
@@ -37,7 +37,7 @@ func ParseTemplateStringAsText(fileName, tmpl string, data interface{}) (string,
 }
 
 func ParseTemplateStringAsHtml(fileName, tmpl string, data interface{}) (string, error) {
-	t, err := ht.New(fileName).Parse(tmpl)
+	t, err := ht.New(fileName).Funcs(Funcs()).Parse(tmpl)
 	if err != nil {
 		return "", err
 	}
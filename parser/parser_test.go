@@ -1 +1,50 @@
 package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	data := map[string]interface{}{
+		"Name": "BlogPost",
+		"Tags": []string{"go", "web"},
+		"Port": 0,
+		"Body": "a\n\nb",
+	}
+	tests := map[string]string{
+		`{{pluralize "post"}} {{singularize "people"}}`:                                         "posts person",
+		`{{snake .Name}} {{kebab .Name}} {{camel .Name}} {{pascal "user_id"}}`:                  "blog_post blog-post blogPost UserID",
+		`{{lowerFirst .Name}} {{quote .Name}}`:                                                  `blogPost "BlogPost"`,
+		`{{.Name | snake | pluralize}}`:                                                         "blog_posts",
+		`{{join ", " .Tags}}|{{.Tags | join "-"}}`:                                              "go, web|go-web",
+		`{{default 8080 .Port}} {{default "x" .Name}} {{default "none" .Missing}}`:              "8080 BlogPost none",
+		`{{indent 2 .Body}}`:                                                                    "  a\n\n  b",
+		`{{define "row"}}{{.Key}}={{.Value}}{{end}}{{template "row" dict "Key" "a" "Value" 1}}`: "a=1",
+	}
+	for tmpl, expected := range tests {
+		actual, err := ParseTemplateStringAsText("file.go", tmpl, data)
+		require.NoError(t, err, tmpl)
+		assert.Equal(t, expected, actual, tmpl)
+	}
+}
+
+func TestTemplateFuncsInHtml(t *testing.T) {
+	actual, err := ParseTemplateStringAsText("index.html", `<a href="/{{kebab .}}">{{pluralize .}}</a>`, "BlogPost")
+	require.NoError(t, err)
+	assert.Equal(t, `<a href="/blog-post">BlogPosts</a>`, actual)
+
+	actual, err = ParseTemplateStringAsHtml("index.html", `<h1>{{.Name | pascal}}</h1>`, map[string]string{"Name": "blog_post"})
+	require.NoError(t, err)
+	assert.Equal(t, "<h1>BlogPost</h1>", actual)
+}
+
+func TestTemplateFuncErrors(t *testing.T) {
+	_, err := ParseTemplateStringAsText("file.go", `{{dict "a"}}`, nil)
+	assert.ErrorContains(t, err, "dict expects key value pairs, got 1 arguments")
+
+	_, err = ParseTemplateStringAsText("file.go", `{{join ", " 1}}`, nil)
+	assert.ErrorContains(t, err, "join expects a list, got int")
+}
@@ -2,12 +2,28 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
 )
 
+// ProjectConfigFiles are the names of the project level plugin settings file, in lookup order.
+var ProjectConfigFiles = []string{"gost.plugins.json", "gost.plugins.yaml", "gost.plugins.yml"}
+
+// WithoutKey is the reserved key of the project settings file listing the features the project is generated without.
+const WithoutKey = "without"
+
 type PluginConfig struct {
 	Settings map[string]interface{}
+	// Without lists the features to leave out, read from the WithoutKey of a project settings file.
+	Without []string
+	// File is the settings file the config was loaded from, if any.
+	File string
 }
 
 func LoadConfig(filePath string) (*PluginConfig, error) {
@@ -25,14 +41,78 @@ func LoadConfig(filePath string) (*PluginConfig, error) {
 		}
 	}(file)
 
+	if ext := strings.ToLower(filepath.Ext(filePath)); ext == ".yaml" || ext == ".yml" {
+		var settings map[string]interface{}
+		if err := yaml.NewDecoder(file).Decode(&settings); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("invalid plugin settings in %s: %w", filePath, err)
+		}
+		// Round trip through JSON so YAML and JSON files yield the same value types.
+		data, err := json.Marshal(settings)
+		if err != nil {
+			return nil, fmt.Errorf("invalid plugin settings in %s: %w", filePath, err)
+		}
+		if err := json.Unmarshal(data, &config.Settings); err != nil {
+			return nil, err
+		}
+		if config.Settings == nil {
+			config.Settings = make(map[string]interface{})
+		}
+		return config, nil
+	}
+
 	decoder := json.NewDecoder(file)
 	if err := decoder.Decode(&config.Settings); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid plugin settings in %s: %w", filePath, err)
 	}
 
 	return config, nil
 }
 
+// LoadProjectConfig loads the first plugin settings file found in dirs, an empty config when there is none.
+func LoadProjectConfig(dirs ...string) (*PluginConfig, error) {
+	for _, dir := range dirs {
+		for _, name := range ProjectConfigFiles {
+			filePath := filepath.Join(dir, name)
+			if _, err := os.Stat(filePath); err == nil {
+				config, err := LoadConfig(filePath)
+				if err != nil {
+					return nil, err
+				}
+				config.File = filePath
+				if err := config.extractWithout(); err != nil {
+					return nil, err
+				}
+				return config, nil
+			}
+		}
+	}
+	return &PluginConfig{Settings: make(map[string]interface{})}, nil
+}
+
+// extractWithout moves the WithoutKey out of the plugin settings, it holds a list or a comma separated string.
+func (c *PluginConfig) extractWithout() error {
+	raw, exists := c.Settings[WithoutKey]
+	if !exists {
+		return nil
+	}
+	delete(c.Settings, WithoutKey)
+	switch value := raw.(type) {
+	case string:
+		c.Without = strings.Split(value, ",")
+	case []interface{}:
+		for _, item := range value {
+			name, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("%q in %s must list feature names", WithoutKey, c.File)
+			}
+			c.Without = append(c.Without, name)
+		}
+	default:
+		return fmt.Errorf("%q in %s must list feature names", WithoutKey, c.File)
+	}
+	return nil
+}
+
 func (c *PluginConfig) GetPluginConfig(pluginName string) map[string]interface{} {
 	if cfg, ok := c.Settings[pluginName].(map[string]interface{}); ok {
 		return cfg
@@ -42,4 +122,8 @@ func (c *PluginConfig) GetPluginConfig(pluginName string) map[string]interface{}
 
 type PluginManagerConfig struct {
 	PluginsDir string
+	// Disabled names plugins that are registered but skipped.
+	Disabled []string
+	// Settings are passed to Configurable plugins before they are initialized.
+	Settings *PluginConfig
 }
@@ -1 +1,82 @@
 package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadProjectConfig(t *testing.T) {
+	empty, err := LoadProjectConfig(t.TempDir())
+	require.NoError(t, err)
+	assert.Empty(t, empty.Settings)
+	assert.Empty(t, empty.File)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gost.plugins.yaml"), []byte("LicensePlugin:\n  license: MIT\n  year: 2024\n"), 0644))
+	config, err := LoadProjectConfig(t.TempDir(), dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "gost.plugins.yaml"), config.File)
+	// YAML numbers are decoded like JSON numbers.
+	assert.Equal(t, map[string]interface{}{"license": "MIT", "year": float64(2024)}, config.GetPluginConfig("LicensePlugin"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gost.plugins.json"), []byte(`{"LicensePlugin": {"license": "Apache-2.0"}}`), 0644))
+	config, err = LoadProjectConfig(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "Apache-2.0", config.GetPluginConfig("LicensePlugin")["license"])
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gost.plugins.json"), []byte(`{"without": ["grpc", "scripts"], "LicensePlugin": {}}`), 0644))
+	config, err = LoadProjectConfig(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"grpc", "scripts"}, config.Without)
+	assert.NotContains(t, config.Settings, "without")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gost.plugins.json"), []byte(`{"without": 3}`), 0644))
+	_, err = LoadProjectConfig(dir)
+	assert.ErrorContains(t, err, `"without" in`)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gost.plugins.json"), []byte(`{"LicensePlugin": `), 0644))
+	_, err = LoadProjectConfig(dir)
+	assert.ErrorContains(t, err, "invalid plugin settings")
+}
+
+func TestSchemaValidate(t *testing.T) {
+	one := 1.0
+	schema := &Schema{
+		Properties: map[string]Property{
+			"license": {Type: "string", Enum: []string{"MIT", "Apache-2.0"}},
+			"owner":   {Type: "string", Pattern: `^[a-z]+$`},
+			"year":    {Type: "integer", Minimum: &one},
+			"header":  {Type: "boolean", Default: true},
+			"paths":   {Type: "array", Items: &Property{Type: "string"}},
+		},
+		Required: []string{"license"},
+	}
+
+	settings, err := schema.Validate(map[string]interface{}{"license": "MIT", "paths": []interface{}{"app"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"license": "MIT", "paths": []interface{}{"app"}, "header": true}, settings)
+
+	_, err = schema.Validate(map[string]interface{}{
+		"licence": "MIT",
+		"owner":   "Acme",
+		"year":    1.5,
+		"paths":   []interface{}{"app", 3.0},
+	})
+	assert.ErrorContains(t, err, `unknown setting "licence", expected one of: header, license, owner, paths, year`)
+	assert.ErrorContains(t, err, `setting "owner" must match ^[a-z]+$, got "Acme"`)
+	assert.ErrorContains(t, err, `setting "year" must be of type integer, got number`)
+	assert.ErrorContains(t, err, `setting "paths[1]" must be of type string, got number`)
+	assert.ErrorContains(t, err, `missing required setting "license"`)
+
+	_, err = schema.Validate(map[string]interface{}{"license": "GPL", "year": 0.0})
+	assert.ErrorContains(t, err, `setting "license" must be one of MIT, Apache-2.0, got "GPL"`)
+	assert.ErrorContains(t, err, `setting "year" must be at least 1, got 0`)
+
+	var none *Schema
+	_, err = none.Validate(map[string]interface{}{"license": "MIT"})
+	assert.EqualError(t, err, `unknown setting "license", the plugin accepts no settings`)
+}
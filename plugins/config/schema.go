@@ -0,0 +1,172 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Schema declares the settings a plugin accepts, modelled on a JSON schema object.
+// Keys missing from Properties are rejected.
+type Schema struct {
+	Properties map[string]Property `json:"properties" yaml:"properties"`
+	Required   []string            `json:"required,omitempty" yaml:"required,omitempty"`
+}
+
+// Property describes a single setting. Type is one of string, number, integer, boolean, array or object.
+type Property struct {
+	Type        string      `json:"type" yaml:"type"`
+	Description string      `json:"description,omitempty" yaml:"description,omitempty"`
+	Default     interface{} `json:"default,omitempty" yaml:"default,omitempty"`
+	Enum        []string    `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Pattern     string      `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Minimum     *float64    `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	Maximum     *float64    `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	Items       *Property   `json:"items,omitempty" yaml:"items,omitempty"`
+}
+
+// Validate checks settings against the schema and returns them with defaults filled in.
+// A nil schema accepts no settings at all.
+func (s *Schema) Validate(settings map[string]interface{}) (map[string]interface{}, error) {
+	if s == nil {
+		s = &Schema{}
+	}
+	var errs []error
+	validated := make(map[string]interface{}, len(s.Properties))
+	for _, key := range sortedKeys(settings) {
+		property, ok := s.Properties[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown setting %q, %s", key, s.expected()))
+			continue
+		}
+		if err := property.validate(key, settings[key]); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		validated[key] = settings[key]
+	}
+	for key, property := range s.Properties {
+		if _, set := validated[key]; !set && property.Default != nil {
+			validated[key] = property.Default
+		}
+	}
+	for _, key := range s.Required {
+		if _, set := settings[key]; !set {
+			errs = append(errs, fmt.Errorf("missing required setting %q", key))
+		}
+	}
+	return validated, errors.Join(errs...)
+}
+
+func (s *Schema) expected() string {
+	if len(s.Properties) == 0 {
+		return "the plugin accepts no settings"
+	}
+	keys := make([]string, 0, len(s.Properties))
+	for key := range s.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return "expected one of: " + strings.Join(keys, ", ")
+}
+
+func (p *Property) validate(key string, value interface{}) error {
+	switch p.Type {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return typeError(key, p.Type, value)
+		}
+		if len(p.Enum) > 0 && !contains(p.Enum, s) {
+			return fmt.Errorf("setting %q must be one of %s, got %q", key, strings.Join(p.Enum, ", "), s)
+		}
+		if p.Pattern != "" {
+			re, err := regexp.Compile(p.Pattern)
+			if err != nil {
+				return fmt.Errorf("setting %q has an invalid pattern: %w", key, err)
+			}
+			if !re.MatchString(s) {
+				return fmt.Errorf("setting %q must match %s, got %q", key, p.Pattern, s)
+			}
+		}
+	case "number", "integer":
+		n, ok := value.(float64)
+		if !ok || (p.Type == "integer" && n != math.Trunc(n)) {
+			return typeError(key, p.Type, value)
+		}
+		if p.Minimum != nil && n < *p.Minimum {
+			return fmt.Errorf("setting %q must be at least %v, got %v", key, *p.Minimum, n)
+		}
+		if p.Maximum != nil && n > *p.Maximum {
+			return fmt.Errorf("setting %q must be at most %v, got %v", key, *p.Maximum, n)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return typeError(key, p.Type, value)
+		}
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return typeError(key, p.Type, value)
+		}
+		if p.Items != nil {
+			for i, item := range items {
+				if err := p.Items.validate(fmt.Sprintf("%s[%d]", key, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	case "object":
+		if _, ok := value.(map[string]interface{}); !ok {
+			return typeError(key, p.Type, value)
+		}
+	case "":
+		// Any value is accepted.
+	default:
+		return fmt.Errorf("setting %q has an unsupported type %q", key, p.Type)
+	}
+	return nil
+}
+
+func typeError(key, expected string, value interface{}) error {
+	actual := "null"
+	switch value.(type) {
+	case string:
+		actual = "string"
+	case float64:
+		actual = "number"
+	case bool:
+		actual = "boolean"
+	case []interface{}:
+		actual = "array"
+	case map[string]interface{}:
+		actual = "object"
+	default:
+		if value != nil {
+			actual = reflect.TypeOf(value).String()
+		}
+	}
+	return fmt.Errorf("setting %q must be of type %s, got %s", key, expected, actual)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
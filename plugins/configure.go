@@ -0,0 +1,73 @@
+package plugins
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/theHamdiz/gost/plugins/config"
+)
+
+// Configurable is implemented by plugins that accept settings from the project's gost.plugins.json or .yaml.
+// The manager validates the plugin's settings against ConfigSchema and calls Configure before Init,
+// even when the file has no entry for the plugin, so defaults and required settings are applied.
+type Configurable interface {
+	ConfigSchema() *config.Schema
+	Configure(settings map[string]interface{}) error
+}
+
+// settingsFile names the settings file in errors.
+func (pm *PluginManager) settingsFile() string {
+	if pm.config != nil && pm.config.Settings != nil && pm.config.Settings.File != "" {
+		return pm.config.Settings.File
+	}
+	return "the plugin settings"
+}
+
+func (pm *PluginManager) settings() map[string]interface{} {
+	if pm.config == nil || pm.config.Settings == nil {
+		return nil
+	}
+	return pm.config.Settings.Settings
+}
+
+// checkSettings rejects settings for plugins that are not registered, usually a typo in the plugin name.
+func (pm *PluginManager) checkSettings() error {
+	var unknown []string
+	for name := range pm.settings() {
+		if _, exists := pm.plugins[name]; !exists {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+	return fmt.Errorf("%s configures plugins that are not registered: %s, registered plugins are: %s",
+		pm.settingsFile(), strings.Join(unknown, ", "), strings.Join(pm.pluginOrder, ", "))
+}
+
+// configure passes a plugin its validated settings.
+func (pm *PluginManager) configure(plugin Plugin) error {
+	raw, exists := pm.settings()[plugin.Name()]
+	configurable, ok := plugin.(Configurable)
+	if !ok {
+		if exists {
+			return fmt.Errorf("plugin %s does not accept settings, remove it from %s", plugin.Name(), pm.settingsFile())
+		}
+		return nil
+	}
+
+	settings, isObject := raw.(map[string]interface{})
+	if exists && !isObject {
+		return fmt.Errorf("settings for plugin %s in %s must be an object", plugin.Name(), pm.settingsFile())
+	}
+	validated, err := configurable.ConfigSchema().Validate(settings)
+	if err != nil {
+		return fmt.Errorf("invalid settings for plugin %s in %s: %w", plugin.Name(), pm.settingsFile(), err)
+	}
+	if err := configurable.Configure(validated); err != nil {
+		return fmt.Errorf("plugin %s failed to configure: %w", plugin.Name(), err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/theHamdiz/gost/plugins/semver"
+)
+
+// Dependency is an entry of Plugin.Dependencies(): a plugin name optionally followed by a
+// version constraint, e.g. "GenTypesPlugin", "GenTypesPlugin>=1.2.0 <2" or "GenTypesPlugin ^1.2".
+type Dependency struct {
+	Name       string
+	Constraint *semver.Constraint
+}
+
+// ParseDependency splits a dependency entry into its plugin name and version constraint.
+func ParseDependency(entry string) (Dependency, error) {
+	entry = strings.TrimSpace(entry)
+	end := strings.IndexAny(entry, "<>=!~^ ")
+	if end == -1 {
+		end = len(entry)
+	}
+	dep := Dependency{Name: entry[:end]}
+	if dep.Name == "" {
+		return dep, fmt.Errorf("invalid dependency %q, expected a plugin name", entry)
+	}
+	if constraint := strings.TrimSpace(entry[end:]); constraint != "" {
+		c, err := semver.ParseConstraint(constraint)
+		if err != nil {
+			return dep, fmt.Errorf("invalid dependency %q: %w", entry, err)
+		}
+		dep.Constraint = c
+	}
+	return dep, nil
+}
+
+func (d Dependency) String() string {
+	if d.Constraint == nil {
+		return d.Name
+	}
+	return d.Name + " " + d.Constraint.String()
+}
+
+// Validate checks that the metadata names a plugin with a semantic version and well formed dependencies.
+func (meta *PluginMetadata) Validate() error {
+	var errs []error
+	if meta.Name == "" {
+		errs = append(errs, errors.New("plugin name cannot be empty"))
+	} else if strings.ContainsAny(meta.Name, `<>=!~^ /\`) || strings.HasPrefix(meta.Name, ".") {
+		errs = append(errs, fmt.Errorf("invalid plugin name %q, names cannot contain spaces, slashes or version operators", meta.Name))
+	}
+	if _, err := semver.Parse(meta.Version); err != nil {
+		errs = append(errs, fmt.Errorf("plugin %s: %w", meta.Name, err))
+	}
+	for _, entry := range meta.Dependencies {
+		if _, err := ParseDependency(entry); err != nil {
+			errs = append(errs, fmt.Errorf("plugin %s: %w", meta.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// dependencies parses the dependency entries of a plugin.
+func dependencies(plugin Plugin) ([]Dependency, error) {
+	var deps []Dependency
+	for _, entry := range plugin.Dependencies() {
+		dep, err := ParseDependency(entry)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s: %w", plugin.Name(), err)
+		}
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
+
+// checkVersions reports every dependency whose registered plugin does not satisfy the required version.
+func (pm *PluginManager) checkVersions() error {
+	var errs []error
+	for _, name := range pm.pluginOrder {
+		if pm.disabled[name] {
+			continue
+		}
+		plugin := pm.plugins[name]
+		deps, err := dependencies(plugin)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, dep := range deps {
+			target, exists := pm.plugins[dep.Name]
+			if !exists || dep.Constraint == nil {
+				continue
+			}
+			version, err := semver.Parse(target.Version())
+			if err != nil {
+				errs = append(errs, fmt.Errorf("plugin %s requires %s, but %s has an invalid version: %w", name, dep, dep.Name, err))
+				continue
+			}
+			if !dep.Constraint.Check(version) {
+				errs = append(errs, fmt.Errorf("plugin %s requires %s, but %s %s is registered", name, dep, dep.Name, version))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -1,14 +1,22 @@
 package loader
 
 import (
-	"io/ioutil"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
 
+	"github.com/theHamdiz/gost/config"
 	"github.com/theHamdiz/gost/plugins"
 	"gopkg.in/yaml.v3"
 )
 
+// MetadataFiles are the names a plugin folder may use for its metadata.
+var MetadataFiles = []string{"plugin.yaml", "plugin.yml"}
+
 func LoadPluginMetadata(metaFile string) (*plugins.PluginMetadata, error) {
-	data, err := ioutil.ReadFile(metaFile)
+	data, err := os.ReadFile(metaFile)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +25,93 @@ func LoadPluginMetadata(metaFile string) (*plugins.PluginMetadata, error) {
 	if err := yaml.Unmarshal(data, &meta); err != nil {
 		return nil, err
 	}
+	if err := meta.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid plugin metadata in %s: %w", metaFile, err)
+	}
 
 	return &meta, nil
 }
+
+// DiscoverPlugins loads every folder of dir that contains plugin metadata as an out-of-process plugin.
+// Folders without metadata are skipped and a missing dir means there are no plugins.
+func DiscoverPlugins(dir string, data config.ProjectData) ([]plugins.Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var discovered []plugins.Plugin
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pluginDir, err := filepath.Abs(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if MetadataFile(pluginDir) == "" {
+			continue
+		}
+		plugin, err := LoadPlugin(pluginDir, data)
+		if err != nil {
+			return nil, err
+		}
+		discovered = append(discovered, plugin)
+	}
+	return discovered, nil
+}
+
+// LoadPlugin loads the out-of-process plugin in pluginDir.
+func LoadPlugin(pluginDir string, data config.ProjectData) (plugins.Plugin, error) {
+	metaFile := MetadataFile(pluginDir)
+	if metaFile == "" {
+		return nil, fmt.Errorf("no %s found in %s", MetadataFiles[0], pluginDir)
+	}
+	meta, err := LoadPluginMetadata(metaFile)
+	if err != nil {
+		return nil, err
+	}
+	executable, err := Executable(pluginDir, meta)
+	if err != nil {
+		return nil, err
+	}
+	return plugins.NewProcessPlugin(*meta, executable, pluginDir, data), nil
+}
+
+// MetadataFile returns the metadata file of pluginDir, or an empty string when it has none.
+func MetadataFile(pluginDir string) string {
+	for _, name := range MetadataFiles {
+		metaFile := filepath.Join(pluginDir, name)
+		if _, err := os.Stat(metaFile); err == nil {
+			return metaFile
+		}
+	}
+	return ""
+}
+
+// Executable resolves the executable named by the metadata, defaulting to the folder name.
+func Executable(pluginDir string, meta *plugins.PluginMetadata) (string, error) {
+	name := meta.Executable
+	if name == "" {
+		name = filepath.Base(pluginDir)
+	}
+	executable := name
+	if !filepath.IsAbs(executable) {
+		executable = filepath.Join(pluginDir, name)
+	}
+	if runtime.GOOS == "windows" && filepath.Ext(executable) == "" {
+		executable += ".exe"
+	}
+
+	info, err := os.Stat(executable)
+	if err != nil {
+		return "", fmt.Errorf("plugin %s: executable %s not found", meta.Name, executable)
+	}
+	if info.IsDir() || (runtime.GOOS != "windows" && info.Mode()&0o111 == 0) {
+		return "", fmt.Errorf("plugin %s: %s is not executable", meta.Name, executable)
+	}
+	return executable, nil
+}
@@ -0,0 +1,153 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theHamdiz/gost/config"
+	"github.com/theHamdiz/gost/plugins"
+	pmCfg "github.com/theHamdiz/gost/plugins/config"
+)
+
+// The test binary doubles as a plugin executable when GOST_TEST_PLUGIN is set.
+func TestMain(m *testing.M) {
+	if mode := os.Getenv("GOST_TEST_PLUGIN"); mode != "" {
+		if err := plugins.Serve(&testHandler{mode: mode}); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if mode == "linger" {
+			select {}
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// testHandler writes its greeting setting to a file named after the app on execute. It fails when mode is "fail",
+// never answers execute when it is "hang" and keeps running after shutdown when it is "linger".
+type testHandler struct {
+	mode     string
+	data     config.ProjectData
+	settings map[string]interface{}
+}
+
+func (h *testHandler) Configure(settings map[string]interface{}) error {
+	h.settings = settings
+	return nil
+}
+
+func (h *testHandler) Init(data config.ProjectData) error {
+	h.data = data
+	return nil
+}
+
+func (h *testHandler) Execute() error {
+	switch h.mode {
+	case "fail":
+		return errors.New("generator failed")
+	case "hang":
+		select {}
+	}
+	greeting, _ := h.settings["greeting"].(string)
+	return os.WriteFile(filepath.Join(h.data.ProjectDir, h.data.AppName+".txt"), []byte(greeting), 0644)
+}
+
+func (h *testHandler) Shutdown() error { return nil }
+
+func writePlugin(t *testing.T, dir, name, mode, meta string) {
+	t.Helper()
+	pluginDir := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(pluginDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "plugin.yaml"), []byte(meta), 0644))
+	script := fmt.Sprintf("#!/bin/sh\nGOST_TEST_PLUGIN=%s exec %q\n", mode, os.Args[0])
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, name), []byte(script), 0755))
+}
+
+func TestDiscoverAndRunProcessPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin executables are shell scripts")
+	}
+	dir := t.TempDir()
+	projectDir := t.TempDir()
+	writePlugin(t, dir, "company", "ok", "name: CompanyPlugin\nversion: 1.2.0\ndependencies: [BasePlugin>=1]\n"+
+		"settings:\n  properties:\n    greeting: {type: string, default: hello}\n")
+	writePlugin(t, dir, "base", "ok", "name: BasePlugin\nversion: 1.0.0\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "notes"), 0755))
+
+	discovered, err := DiscoverPlugins(dir, config.ProjectData{AppName: "blog", ProjectDir: projectDir})
+	require.NoError(t, err)
+	require.Len(t, discovered, 2)
+
+	settings := &pmCfg.PluginConfig{Settings: map[string]interface{}{"CompanyPlugin": map[string]interface{}{"greeting": "hi"}}}
+	pm := plugins.NewPluginManager(&pmCfg.PluginManagerConfig{PluginsDir: dir, Settings: settings})
+	require.NoError(t, pm.RegisterPlugins(discovered))
+	require.NoError(t, pm.InitPlugins())
+	require.NoError(t, pm.ExecutePlugins())
+	require.NoError(t, pm.ShutdownPlugins())
+	generated, err := os.ReadFile(filepath.Join(projectDir, "blog.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hi", string(generated))
+
+	missing, err := DiscoverPlugins(filepath.Join(dir, "missing"), config.ProjectData{})
+	assert.NoError(t, err)
+	assert.Empty(t, missing)
+}
+
+func TestProcessPluginErrors(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin executables are shell scripts")
+	}
+	dir := t.TempDir()
+	writePlugin(t, dir, "broken", "fail", "name: BrokenPlugin\nversion: 1.0.0\n")
+
+	discovered, err := DiscoverPlugins(dir, config.ProjectData{ProjectDir: t.TempDir()})
+	require.NoError(t, err)
+	pm := plugins.NewPluginManager(&pmCfg.PluginManagerConfig{PluginsDir: dir})
+	require.NoError(t, pm.RegisterPlugins(discovered))
+	require.NoError(t, pm.InitPlugins())
+	assert.EqualError(t, pm.ExecutePlugins(), "plugin BrokenPlugin failed to execute: generator failed")
+	assert.NoError(t, pm.ShutdownPlugins())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken", "plugin.yaml"), []byte("name: BrokenPlugin\nversion: 1.0.0\nexecutable: run.sh\n"), 0644))
+	_, err = DiscoverPlugins(dir, config.ProjectData{})
+	assert.ErrorContains(t, err, "executable")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken", "plugin.yaml"), []byte("name: BrokenPlugin\nversion: one\n"), 0644))
+	_, err = DiscoverPlugins(dir, config.ProjectData{})
+	assert.ErrorContains(t, err, "invalid plugin metadata")
+}
+
+func TestUnresponsivePluginsAreKilled(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin executables are shell scripts")
+	}
+	callTimeout, exitTimeout := plugins.CallTimeout, plugins.ExitTimeout
+	plugins.CallTimeout, plugins.ExitTimeout = 500*time.Millisecond, 500*time.Millisecond
+	t.Cleanup(func() { plugins.CallTimeout, plugins.ExitTimeout = callTimeout, exitTimeout })
+
+	run := func(mode string) (executeErr, shutdownErr error) {
+		dir := t.TempDir()
+		writePlugin(t, dir, mode, mode, "name: StuckPlugin\nversion: 1.0.0\n")
+		discovered, err := DiscoverPlugins(dir, config.ProjectData{AppName: "blog", ProjectDir: t.TempDir()})
+		require.NoError(t, err)
+		pm := plugins.NewPluginManager(&pmCfg.PluginManagerConfig{PluginsDir: dir})
+		require.NoError(t, pm.RegisterPlugins(discovered))
+		require.NoError(t, pm.InitPlugins())
+		return pm.ExecutePlugins(), pm.ShutdownPlugins()
+	}
+
+	executeErr, _ := run("hang")
+	assert.ErrorContains(t, executeErr, "StuckPlugin did not answer execute within 500ms and was killed")
+
+	executeErr, shutdownErr := run("linger")
+	assert.NoError(t, executeErr)
+	assert.ErrorContains(t, shutdownErr, "StuckPlugin did not exit within 500ms of shutdown and was killed")
+}
@@ -3,6 +3,8 @@ package plugins
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/theHamdiz/gost/plugins/config"
 )
@@ -36,11 +38,15 @@ type PluginMetadata struct {
 	AuthorEmail  string   `yaml:"author_email"  json:"author_email"`
 	Website      string   `yaml:"website"  json:"website"`
 	GitHub       string   `yaml:"github"  json:"github"`
+	Executable   string   `yaml:"executable,omitempty"  json:"executable,omitempty"`
+	// Settings declares the settings an out-of-process plugin accepts.
+	Settings *config.Schema `yaml:"settings,omitempty"  json:"settings,omitempty"`
 }
 
 type PluginManager struct {
 	plugins     map[string]Plugin
 	pluginOrder []string
+	disabled    map[string]bool
 	config      *config.PluginManagerConfig
 }
 
@@ -48,6 +54,9 @@ func (pm *PluginManager) RegisterPlugin(plugin Plugin) error {
 	if plugin.Name() == "" {
 		return errors.New("plugin name cannot be empty")
 	}
+	if _, exists := pm.plugins[plugin.Name()]; exists {
+		return fmt.Errorf("plugin %s is already registered", plugin.Name())
+	}
 	pm.plugins[plugin.Name()] = plugin
 	pm.pluginOrder = append(pm.pluginOrder, plugin.Name())
 	return nil
@@ -64,75 +73,162 @@ func (pm *PluginManager) RegisterPlugins(plugins []Plugin) error {
 }
 
 func NewPluginManager(config *config.PluginManagerConfig) *PluginManager {
-	return &PluginManager{
-		plugins: make(map[string]Plugin),
-		config:  config,
+	pm := &PluginManager{
+		plugins:  make(map[string]Plugin),
+		disabled: make(map[string]bool),
+		config:   config,
+	}
+	if config != nil {
+		for _, name := range config.Disabled {
+			pm.Disable(name)
+		}
 	}
+	return pm
+}
+
+// Disable skips a plugin in Init, Execute and Shutdown. Plugins depending on it fail to resolve.
+func (pm *PluginManager) Disable(name string) {
+	pm.disabled[name] = true
+}
+
+// Enable reverts Disable.
+func (pm *PluginManager) Enable(name string) {
+	delete(pm.disabled, name)
+}
+
+// Enabled reports whether a plugin is registered and not disabled.
+func (pm *PluginManager) Enabled(name string) bool {
+	_, exists := pm.plugins[name]
+	return exists && !pm.disabled[name]
 }
 
+// resolveDependencies orders the enabled plugins so every plugin comes after its dependencies.
+// Plugins without a dependency between them keep their registration order.
 func (pm *PluginManager) resolveDependencies() ([]Plugin, error) {
-	resolved := make(map[string]bool)
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(pm.plugins))
+	var path []string
 	var order []Plugin
 
 	var resolve func(name string) error
 	resolve = func(name string) error {
-		if resolved[name] {
+		switch state[name] {
+		case visited:
 			return nil
+		case visiting:
+			// The cycle starts where name first appears on the current path.
+			for i, step := range path {
+				if step == name {
+					return fmt.Errorf("dependency cycle between plugins: %s", strings.Join(append(path[i:], name), " -> "))
+				}
+			}
 		}
 
-		plugin, exists := pm.plugins[name]
-		if !exists {
-			return fmt.Errorf("plugin %s not found", name)
+		plugin := pm.plugins[name]
+		state[name] = visiting
+		path = append(path, name)
+		deps, err := dependencies(plugin)
+		if err != nil {
+			return err
 		}
-
-		for _, dep := range plugin.Dependencies() {
-			if err := resolve(dep); err != nil {
+		for _, dep := range deps {
+			if _, exists := pm.plugins[dep.Name]; !exists {
+				return fmt.Errorf("plugin %s depends on %s, which is not registered", name, dep.Name)
+			}
+			if pm.disabled[dep.Name] {
+				return fmt.Errorf("plugin %s depends on %s, which is disabled", name, dep.Name)
+			}
+			if err := resolve(dep.Name); err != nil {
 				return err
 			}
 		}
-
-		resolved[name] = true
+		path = path[:len(path)-1]
+		state[name] = visited
 		order = append(order, plugin)
 		return nil
 	}
 
-	for name := range pm.plugins {
+	for _, name := range sortedKeys(pm.disabled) {
+		if _, exists := pm.plugins[name]; !exists {
+			return nil, fmt.Errorf("cannot disable plugin %s, it is not registered", name)
+		}
+	}
+	for _, name := range pm.pluginOrder {
+		if pm.disabled[name] {
+			continue
+		}
 		if err := resolve(name); err != nil {
 			return nil, err
 		}
 	}
+	if err := pm.checkVersions(); err != nil {
+		return nil, err
+	}
 
 	return order, nil
 }
 
+// InitPlugins configures and initializes the enabled plugins in dependency order.
+// A failing plugin does not stop the others, but its dependents are skipped, see Report.
 func (pm *PluginManager) InitPlugins() error {
-	for _, name := range pm.pluginOrder {
-		plugin := pm.plugins[name]
-		if err := plugin.Init(); err != nil {
+	order, err := pm.resolveDependencies()
+	if err != nil {
+		return err
+	}
+	if err := pm.checkSettings(); err != nil {
+		return err
+	}
+	return pm.run("initialize", order, func(plugin Plugin) error {
+		if err := pm.configure(plugin); err != nil {
 			return err
 		}
-	}
-	return nil
+		if err := plugin.Init(); err != nil {
+			return fmt.Errorf("plugin %s failed to initialize: %w", plugin.Name(), err)
+		}
+		return nil
+	})
 }
 
+// ExecutePlugins executes the enabled plugins in dependency order, collecting failures like InitPlugins.
 func (pm *PluginManager) ExecutePlugins() error {
-	for _, name := range pm.pluginOrder {
-		plugin := pm.plugins[name]
+	order, err := pm.resolveDependencies()
+	if err != nil {
+		return err
+	}
+	return pm.run("execute", order, func(plugin Plugin) error {
 		if err := plugin.Execute(); err != nil {
-			return err
+			return fmt.Errorf("plugin %s failed to execute: %w", plugin.Name(), err)
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
+// ShutdownPlugins shuts every plugin down in reverse dependency order, so dependencies outlive their dependents.
+// A failing shutdown does not stop the remaining plugins from shutting down.
 func (pm *PluginManager) ShutdownPlugins() error {
-	// Reverse the order for shutdown
-	for i := len(pm.pluginOrder) - 1; i >= 0; i-- {
-		name := pm.pluginOrder[i]
-		plugin := pm.plugins[name]
-		if err := plugin.Shutdown(); err != nil {
-			return err
+	order, err := pm.resolveDependencies()
+	if err != nil {
+		// InitPlugins failed with the same error, so no plugin was started.
+		return nil
+	}
+	var errs []error
+	for i := len(order) - 1; i >= 0; i-- {
+		if err := order[i].Shutdown(); err != nil {
+			errs = append(errs, fmt.Errorf("plugin %s failed to shut down: %w", order[i].Name(), err))
 		}
 	}
-	return nil
+	return errors.Join(errs...)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
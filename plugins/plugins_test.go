@@ -1 +1,253 @@
 package plugins
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/theHamdiz/gost/plugins/config"
+)
+
+// fakePlugin records the calls it receives in a shared log.
+type fakePlugin struct {
+	name        string
+	version     string
+	deps        []string
+	log         *[]string
+	executeErr  error
+	shutdownErr error
+}
+
+func (p *fakePlugin) Init() error {
+	*p.log = append(*p.log, "init "+p.name)
+	return nil
+}
+
+func (p *fakePlugin) Execute() error {
+	*p.log = append(*p.log, "execute "+p.name)
+	return p.executeErr
+}
+
+func (p *fakePlugin) Shutdown() error {
+	*p.log = append(*p.log, "shutdown "+p.name)
+	return p.shutdownErr
+}
+
+func (p *fakePlugin) Name() string           { return p.name }
+func (p *fakePlugin) Version() string        { return p.version }
+func (p *fakePlugin) Dependencies() []string { return p.deps }
+func (p *fakePlugin) AuthorName() string     { return "" }
+func (p *fakePlugin) AuthorEmail() string    { return "" }
+func (p *fakePlugin) Website() string        { return "" }
+func (p *fakePlugin) GitHub() string         { return "" }
+
+func newManager(t *testing.T, log *[]string, plugins ...*fakePlugin) *PluginManager {
+	pm := NewPluginManager(&config.PluginManagerConfig{})
+	for _, plugin := range plugins {
+		plugin.log = log
+		if plugin.version == "" {
+			plugin.version = "1.0.0"
+		}
+		assert.NoError(t, pm.RegisterPlugin(plugin))
+	}
+	return pm
+}
+
+func TestPluginsRunInDependencyOrder(t *testing.T) {
+	var log []string
+	pm := newManager(t, &log,
+		&fakePlugin{name: "files", deps: []string{"router", "db"}},
+		&fakePlugin{name: "router", deps: []string{"types"}},
+		&fakePlugin{name: "db"},
+		&fakePlugin{name: "types"},
+	)
+
+	assert.NoError(t, pm.InitPlugins())
+	assert.NoError(t, pm.ExecutePlugins())
+	assert.NoError(t, pm.ShutdownPlugins())
+	assert.Equal(t, []string{
+		"init types", "init router", "init db", "init files",
+		"execute types", "execute router", "execute db", "execute files",
+		"shutdown files", "shutdown db", "shutdown router", "shutdown types",
+	}, log)
+}
+
+func TestDependencyErrors(t *testing.T) {
+	var log []string
+	pm := newManager(t, &log,
+		&fakePlugin{name: "a", deps: []string{"b"}},
+		&fakePlugin{name: "b", deps: []string{"c"}},
+		&fakePlugin{name: "c", deps: []string{"a"}},
+	)
+	assert.EqualError(t, pm.InitPlugins(), "dependency cycle between plugins: a -> b -> c -> a")
+	assert.Empty(t, log)
+
+	pm = newManager(t, &log, &fakePlugin{name: "router", deps: []string{"types"}})
+	assert.EqualError(t, pm.ExecutePlugins(), "plugin router depends on types, which is not registered")
+
+	assert.Error(t, pm.RegisterPlugin(&fakePlugin{name: "router"}))
+}
+
+func TestShutdownContinuesAfterAFailure(t *testing.T) {
+	var log []string
+	pm := newManager(t, &log,
+		&fakePlugin{name: "types"},
+		&fakePlugin{name: "router", deps: []string{"types"}, shutdownErr: errors.New("boom")},
+	)
+	err := pm.ShutdownPlugins()
+	assert.ErrorContains(t, err, "plugin router failed to shut down: boom")
+	assert.Equal(t, []string{"shutdown router", "shutdown types"}, log)
+}
+
+func TestVersionConstraints(t *testing.T) {
+	var log []string
+	pm := newManager(t, &log,
+		&fakePlugin{name: "types", version: "1.4.0"},
+		&fakePlugin{name: "router", deps: []string{"types>=1.2.0 <2"}},
+		&fakePlugin{name: "files", deps: []string{"router ^1", "types ~1.4"}},
+	)
+	assert.NoError(t, pm.InitPlugins())
+	assert.Equal(t, []string{"init types", "init router", "init files"}, log)
+
+	log = nil
+	pm = newManager(t, &log,
+		&fakePlugin{name: "types", version: "2.1.0"},
+		&fakePlugin{name: "db", version: "latest"},
+		&fakePlugin{name: "router", deps: []string{"types>=1.2.0 <2"}},
+		&fakePlugin{name: "files", deps: []string{"types ^2", "db>=1"}},
+	)
+	err := pm.ExecutePlugins()
+	assert.ErrorContains(t, err, "plugin router requires types >=1.2.0 <2, but types 2.1.0 is registered")
+	assert.ErrorContains(t, err, "plugin files requires db >=1, but db has an invalid version")
+	assert.NotContains(t, err.Error(), "types ^2")
+	assert.Empty(t, log)
+
+	pm = newManager(t, &log, &fakePlugin{name: "router", deps: []string{"types>=one"}})
+	assert.ErrorContains(t, pm.InitPlugins(), `plugin router: invalid dependency "types>=one"`)
+}
+
+func TestParseDependency(t *testing.T) {
+	dep, err := ParseDependency("GenTypesPlugin>=1.2.0 <2")
+	assert.NoError(t, err)
+	assert.Equal(t, "GenTypesPlugin", dep.Name)
+	assert.Equal(t, "GenTypesPlugin >=1.2.0 <2", dep.String())
+
+	dep, err = ParseDependency("GenTypesPlugin")
+	assert.NoError(t, err)
+	assert.Nil(t, dep.Constraint)
+
+	_, err = ParseDependency(">=1.0.0")
+	assert.Error(t, err)
+}
+
+func TestMetadataValidate(t *testing.T) {
+	meta := PluginMetadata{Name: "GenRouterPlugin", Version: "1.0.0", Dependencies: []string{"GenTypesPlugin ^1.2"}}
+	assert.NoError(t, meta.Validate())
+
+	meta.Version = "1.0"
+	meta.Dependencies = append(meta.Dependencies, "GenDbPlugin>=x")
+	err := meta.Validate()
+	assert.ErrorContains(t, err, `invalid version "1.0"`)
+	assert.ErrorContains(t, err, `invalid dependency "GenDbPlugin>=x"`)
+}
+
+// configurablePlugin records the settings it was configured with.
+type configurablePlugin struct {
+	fakePlugin
+	schema   *config.Schema
+	settings map[string]interface{}
+}
+
+func (p *configurablePlugin) ConfigSchema() *config.Schema { return p.schema }
+
+func (p *configurablePlugin) Configure(settings map[string]interface{}) error {
+	*p.log = append(*p.log, "configure "+p.name)
+	p.settings = settings
+	return nil
+}
+
+func TestConfigurablePlugins(t *testing.T) {
+	var log []string
+	license := &configurablePlugin{
+		fakePlugin: fakePlugin{name: "license", version: "1.0.0", log: &log},
+		schema: &config.Schema{Properties: map[string]config.Property{
+			"license": {Type: "string", Default: "MIT"},
+			"owner":   {Type: "string"},
+		}},
+	}
+	newPM := func(settings map[string]interface{}, plugins ...Plugin) *PluginManager {
+		pm := NewPluginManager(&config.PluginManagerConfig{Settings: &config.PluginConfig{Settings: settings, File: "gost.plugins.json"}})
+		assert.NoError(t, pm.RegisterPlugins(plugins))
+		return pm
+	}
+
+	pm := newPM(map[string]interface{}{"license": map[string]interface{}{"owner": "acme"}}, license)
+	assert.NoError(t, pm.InitPlugins())
+	assert.Equal(t, map[string]interface{}{"license": "MIT", "owner": "acme"}, license.settings)
+	assert.Equal(t, []string{"configure license", "init license"}, log)
+
+	pm = newPM(map[string]interface{}{"license": map[string]interface{}{"ownr": "acme"}}, license)
+	assert.EqualError(t, pm.InitPlugins(), `invalid settings for plugin license in gost.plugins.json: unknown setting "ownr", expected one of: license, owner`)
+
+	pm = newPM(map[string]interface{}{"license": "MIT"}, license)
+	assert.EqualError(t, pm.InitPlugins(), "settings for plugin license in gost.plugins.json must be an object")
+
+	types := &fakePlugin{name: "types", version: "1.0.0", log: &log}
+	pm = newPM(map[string]interface{}{"types": map[string]interface{}{}}, types)
+	assert.EqualError(t, pm.InitPlugins(), "plugin types does not accept settings, remove it from gost.plugins.json")
+
+	pm = newPM(map[string]interface{}{"licence": map[string]interface{}{}}, license, types)
+	assert.EqualError(t, pm.InitPlugins(), "gost.plugins.json configures plugins that are not registered: licence, registered plugins are: license, types")
+}
+
+func TestDisabledPlugins(t *testing.T) {
+	var log []string
+	pm := NewPluginManager(&config.PluginManagerConfig{Disabled: []string{"scripts"}})
+	for _, plugin := range []*fakePlugin{
+		{name: "types", version: "1.0.0"},
+		{name: "router", version: "1.0.0", deps: []string{"types"}},
+		{name: "scripts", version: "1.0.0", deps: []string{"missing-but-disabled"}},
+	} {
+		plugin.log = &log
+		assert.NoError(t, pm.RegisterPlugin(plugin))
+	}
+	assert.False(t, pm.Enabled("scripts"))
+	assert.True(t, pm.Enabled("router"))
+
+	assert.NoError(t, pm.InitPlugins())
+	assert.NoError(t, pm.ShutdownPlugins())
+	assert.Equal(t, []string{"init types", "init router", "shutdown router", "shutdown types"}, log)
+
+	pm.Disable("types")
+	assert.EqualError(t, pm.ExecutePlugins(), "plugin router depends on types, which is disabled")
+	pm.Disable("router")
+	assert.NoError(t, pm.ExecutePlugins())
+
+	pm.Enable("scripts")
+	assert.EqualError(t, pm.ExecutePlugins(), "plugin scripts depends on missing-but-disabled, which is not registered")
+
+	pm = NewPluginManager(&config.PluginManagerConfig{Disabled: []string{"mailer"}})
+	assert.EqualError(t, pm.InitPlugins(), "cannot disable plugin mailer, it is not registered")
+}
+
+func TestFailuresAreCollected(t *testing.T) {
+	var log []string
+	pm := newManager(t, &log,
+		&fakePlugin{name: "types", executeErr: errors.New("template app/types/core.go: boom\ntemplate app/types/user.go: bang")},
+		&fakePlugin{name: "router", deps: []string{"types"}},
+		&fakePlugin{name: "files", deps: []string{"router"}},
+		&fakePlugin{name: "db"},
+	)
+	err := pm.ExecutePlugins()
+	assert.Equal(t, []string{"execute types", "execute db"}, log)
+
+	var report *Report
+	assert.ErrorAs(t, err, &report)
+	assert.Equal(t, []string{"types"}, report.Failed())
+	assert.Len(t, report.Failures, 3)
+	assert.Equal(t, Failure{Plugin: "router", Stage: "execute", SkippedFor: "types"}, report.Failures[1])
+	assert.EqualError(t, err, "plugin types failed to execute: template app/types/core.go: boom\n"+
+		"  template app/types/user.go: bang\n"+
+		"plugin router was not executed, it depends on types which failed\n"+
+		"plugin files was not executed, it depends on router which failed")
+}
@@ -0,0 +1,238 @@
+package plugins
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/theHamdiz/gost/config"
+	pmConfig "github.com/theHamdiz/gost/plugins/config"
+)
+
+// Out-of-process plugins talk to gost over their stdin and stdout, one JSON object per line.
+// gost sends a request for every Plugin method and waits for its response before sending the next:
+//
+//	-> {"method":"init","project":{"AppName":"blog",...},"settings":{"license":"MIT"}}
+//	<- {}
+//	-> {"method":"execute"}
+//	<- {"error":"templates/handler.tmpl: no such file"}
+//	-> {"method":"shutdown"}
+//	<- {}
+//
+// The settings are validated against the settings schema of plugin.yaml first. Plugins write their files
+// under the project's OutputDir, which only reaches ProjectDir when every plugin succeeded.
+// The process is started before init and must exit after answering shutdown. It is killed when it takes
+// longer than CallTimeout to answer a request or than ExitTimeout to exit after shutdown.
+// Anything it writes to stderr is shown to the user, stdout is reserved for responses.
+const (
+	MethodInit     = "init"
+	MethodExecute  = "execute"
+	MethodShutdown = "shutdown"
+)
+
+var (
+	// CallTimeout is how long an out-of-process plugin has to answer a request.
+	CallTimeout = 5 * time.Minute
+	// ExitTimeout is how long an out-of-process plugin has to exit after answering shutdown.
+	ExitTimeout = 5 * time.Second
+)
+
+// Request is sent by gost to an out-of-process plugin, Project and Settings are only set for init.
+type Request struct {
+	Method   string                 `json:"method"`
+	Project  *config.ProjectData    `json:"project,omitempty"`
+	Settings map[string]interface{} `json:"settings,omitempty"`
+}
+
+// Response answers a Request, an empty Error means the call succeeded.
+type Response struct {
+	Error string `json:"error,omitempty"`
+}
+
+// ProcessPlugin runs a plugin executable as a subprocess speaking the JSON protocol above.
+type ProcessPlugin struct {
+	meta       PluginMetadata
+	executable string
+	dir        string
+	data       config.ProjectData
+	settings   map[string]interface{}
+
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	encoder *json.Encoder
+	decoder *json.Decoder
+}
+
+// NewProcessPlugin creates a plugin that runs executable from dir, described by meta.
+func NewProcessPlugin(meta PluginMetadata, executable, dir string, data config.ProjectData) *ProcessPlugin {
+	return &ProcessPlugin{meta: meta, executable: executable, dir: dir, data: data}
+}
+
+func (p *ProcessPlugin) Init() error {
+	p.cmd = exec.Command(p.executable)
+	p.cmd.Dir = p.dir
+	p.cmd.Stderr = os.Stderr
+	stdin, err := p.cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := p.cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := p.cmd.Start(); err != nil {
+		return fmt.Errorf("could not start %s: %w", p.executable, err)
+	}
+	p.stdin = stdin
+	p.encoder = json.NewEncoder(stdin)
+	p.decoder = json.NewDecoder(bufio.NewReader(stdout))
+	return p.call(Request{Method: MethodInit, Project: &p.data, Settings: p.settings})
+}
+
+func (p *ProcessPlugin) ConfigSchema() *pmConfig.Schema {
+	return p.meta.Settings
+}
+
+// Configure keeps the settings until the process is started by Init.
+func (p *ProcessPlugin) Configure(settings map[string]interface{}) error {
+	p.settings = settings
+	return nil
+}
+
+func (p *ProcessPlugin) Execute() error {
+	return p.call(Request{Method: MethodExecute})
+}
+
+// Shutdown asks the process to stop and waits for it to exit, it does nothing if the process never started.
+func (p *ProcessPlugin) Shutdown() error {
+	if p.cmd == nil || p.cmd.Process == nil {
+		return nil
+	}
+	err := p.call(Request{Method: MethodShutdown})
+	_ = p.stdin.Close()
+	exited := make(chan error, 1)
+	go func() { exited <- p.cmd.Wait() }()
+	select {
+	case waitErr := <-exited:
+		if err == nil && waitErr != nil {
+			err = fmt.Errorf("%s exited: %w", p.executable, waitErr)
+		}
+	case <-time.After(ExitTimeout):
+		_ = p.cmd.Process.Kill()
+		<-exited
+		if err == nil {
+			err = fmt.Errorf("%s did not exit within %s of shutdown and was killed", p.meta.Name, ExitTimeout)
+		}
+	}
+	p.cmd = nil
+	return err
+}
+
+// call sends request and waits for the response, killing the process if it does not answer within CallTimeout.
+func (p *ProcessPlugin) call(request Request) error {
+	if p.encoder == nil {
+		return fmt.Errorf("%s was called before init", request.Method)
+	}
+	answered := make(chan error, 1)
+	go func() { answered <- p.roundTrip(request) }()
+	select {
+	case err := <-answered:
+		return err
+	case <-time.After(CallTimeout):
+		_ = p.cmd.Process.Kill()
+		<-answered
+		return fmt.Errorf("%s did not answer %s within %s and was killed", p.meta.Name, request.Method, CallTimeout)
+	}
+}
+
+func (p *ProcessPlugin) roundTrip(request Request) error {
+	if err := p.encoder.Encode(request); err != nil {
+		return fmt.Errorf("could not send %s: %w", request.Method, err)
+	}
+	var response Response
+	if err := p.decoder.Decode(&response); err != nil {
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("%s exited without answering %s", p.executable, request.Method)
+		}
+		return fmt.Errorf("invalid response to %s: %w", request.Method, err)
+	}
+	if response.Error != "" {
+		return errors.New(response.Error)
+	}
+	return nil
+}
+
+func (p *ProcessPlugin) Name() string           { return p.meta.Name }
+func (p *ProcessPlugin) Version() string        { return p.meta.Version }
+func (p *ProcessPlugin) Dependencies() []string { return p.meta.Dependencies }
+func (p *ProcessPlugin) AuthorName() string     { return p.meta.AuthorName }
+func (p *ProcessPlugin) AuthorEmail() string    { return p.meta.AuthorEmail }
+func (p *ProcessPlugin) Website() string        { return p.meta.Website }
+func (p *ProcessPlugin) GitHub() string         { return p.meta.GitHub }
+
+// ProcessHandler is implemented by out-of-process plugins written in Go, see Serve.
+type ProcessHandler interface {
+	Init(data config.ProjectData) error
+	Execute() error
+	Shutdown() error
+}
+
+// ConfigurableHandler is implemented by handlers that accept settings, Configure is called right before Init.
+type ConfigurableHandler interface {
+	Configure(settings map[string]interface{}) error
+}
+
+// Serve answers gost's requests on stdin and stdout until shutdown, it is the main loop of a Go plugin executable.
+func Serve(handler ProcessHandler) error {
+	return serve(handler, os.Stdin, os.Stdout)
+}
+
+func serve(handler ProcessHandler, r io.Reader, w io.Writer) error {
+	decoder := json.NewDecoder(r)
+	encoder := json.NewEncoder(w)
+	for {
+		var request Request
+		if err := decoder.Decode(&request); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		var err error
+		switch request.Method {
+		case MethodInit:
+			if request.Project == nil {
+				err = errors.New("init requires the project data")
+			} else if configurable, ok := handler.(ConfigurableHandler); ok {
+				if err = configurable.Configure(request.Settings); err == nil {
+					err = handler.Init(*request.Project)
+				}
+			} else {
+				err = handler.Init(*request.Project)
+			}
+		case MethodExecute:
+			err = handler.Execute()
+		case MethodShutdown:
+			err = handler.Shutdown()
+		default:
+			err = fmt.Errorf("unknown method %q", request.Method)
+		}
+
+		var response Response
+		if err != nil {
+			response.Error = err.Error()
+		}
+		if err := encoder.Encode(response); err != nil {
+			return err
+		}
+		if request.Method == MethodShutdown {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package plugins
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Failure is a plugin that failed in a lifecycle stage, or was skipped because a plugin it depends on failed.
+type Failure struct {
+	Plugin string
+	// Stage is "initialize" or "execute".
+	Stage string
+	Err   error
+	// SkippedFor names the failed dependency when the plugin did not run at all.
+	SkippedFor string
+}
+
+func (f Failure) Error() string {
+	if f.SkippedFor != "" {
+		return fmt.Sprintf("plugin %s was not %s, it depends on %s which failed", f.Plugin, pastTense(f.Stage), f.SkippedFor)
+	}
+	return f.Err.Error()
+}
+
+func (f Failure) Unwrap() error {
+	return f.Err
+}
+
+func pastTense(stage string) string {
+	if stage == "initialize" {
+		return "initialized"
+	}
+	return stage + "d"
+}
+
+// Report collects every failure of a lifecycle stage, in the order the plugins ran.
+type Report struct {
+	Failures []Failure
+}
+
+func (r *Report) Error() string {
+	lines := make([]string, len(r.Failures))
+	for i, failure := range r.Failures {
+		// Indent multi line errors, like the templates of a plugin that failed to render.
+		lines[i] = strings.ReplaceAll(failure.Error(), "\n", "\n  ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *Report) Unwrap() []error {
+	errs := make([]error, len(r.Failures))
+	for i, failure := range r.Failures {
+		errs[i] = failure
+	}
+	return errs
+}
+
+// Failed returns the plugins that failed themselves, leaving out the ones that were skipped.
+func (r *Report) Failed() []string {
+	var names []string
+	for _, failure := range r.Failures {
+		if failure.SkippedFor == "" {
+			names = append(names, failure.Plugin)
+		}
+	}
+	return names
+}
+
+// run calls fn for every plugin in order, skipping plugins whose dependencies failed instead of stopping
+// at the first failure, and returns a *Report when anything failed.
+func (pm *PluginManager) run(stage string, order []Plugin, fn func(plugin Plugin) error) error {
+	report := &Report{}
+	failed := make(map[string]bool)
+	for _, plugin := range order {
+		if dep := failedDependency(plugin, failed); dep != "" {
+			failed[plugin.Name()] = true
+			report.Failures = append(report.Failures, Failure{Plugin: plugin.Name(), Stage: stage, SkippedFor: dep})
+			continue
+		}
+		if err := fn(plugin); err != nil {
+			failed[plugin.Name()] = true
+			report.Failures = append(report.Failures, Failure{Plugin: plugin.Name(), Stage: stage, Err: err})
+		}
+	}
+	if len(report.Failures) == 0 {
+		return nil
+	}
+	return report
+}
+
+func failedDependency(plugin Plugin, failed map[string]bool) string {
+	deps, _ := dependencies(plugin)
+	for _, dep := range deps {
+		if failed[dep.Name] {
+			return dep.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,246 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// operatorSpace matches the spaces allowed between an operator and its version, as in ">= 1.2.0".
+var operatorSpace = regexp.MustCompile(`([<>=!~^]+)\s+`)
+
+// Version is a semantic version, see https://semver.org. Build metadata is ignored.
+type Version struct {
+	Major, Minor, Patch int
+	Prerelease          string
+}
+
+// Parse parses versions like 1.2.3, v1.2.3 and 1.2.3-beta.1.
+func Parse(s string) (Version, error) {
+	v, parts, err := parsePartial(s)
+	if err != nil {
+		return Version{}, err
+	}
+	if parts != 3 {
+		return Version{}, fmt.Errorf("invalid version %q, expected major.minor.patch", s)
+	}
+	return v, nil
+}
+
+// parsePartial also accepts 1 and 1.2, returning how many numeric parts were given.
+func parsePartial(s string) (Version, int, error) {
+	raw := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	raw, _, _ = strings.Cut(raw, "+")
+	var v Version
+	raw, v.Prerelease, _ = strings.Cut(raw, "-")
+
+	fields := strings.Split(raw, ".")
+	if raw == "" || len(fields) > 3 {
+		return Version{}, 0, fmt.Errorf("invalid version %q", s)
+	}
+	numbers := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return Version{}, 0, fmt.Errorf("invalid version %q", s)
+		}
+		*numbers[i] = n
+	}
+	return v, len(fields), nil
+}
+
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 when v is lower than, equal to or greater than other.
+func (v Version) Compare(other Version) int {
+	for _, d := range []int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		if d != 0 {
+			return sign(d)
+		}
+	}
+	return comparePrerelease(v.Prerelease, other.Prerelease)
+}
+
+// comparePrerelease orders prereleases before releases and compares dot separated identifiers,
+// numeric identifiers numerically.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				return sign(an - bn)
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+	}
+	return sign(len(as) - len(bs))
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+type comparator struct {
+	op      string
+	version Version
+}
+
+func (c comparator) check(v Version) bool {
+	cmp := v.Compare(c.version)
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	default: // "<="
+		return cmp <= 0
+	}
+}
+
+// Constraint is a set of alternatives separated by ||, each a list of comparators that must all match,
+// e.g. ">=1.2.0 <2", "^1.2 || ^2" or "~1.4.0". Partial versions are completed with zeros, so <2 means <2.0.0.
+type Constraint struct {
+	raw  string
+	sets [][]comparator
+}
+
+// ParseConstraint parses a version constraint, an empty constraint matches every version.
+func ParseConstraint(s string) (*Constraint, error) {
+	c := &Constraint{raw: strings.TrimSpace(s)}
+	for _, alternative := range strings.Split(operatorSpace.ReplaceAllString(s, "$1"), "||") {
+		var set []comparator
+		for _, term := range strings.FieldsFunc(alternative, func(r rune) bool { return r == ' ' || r == ',' }) {
+			comparators, err := parseTerm(term)
+			if err != nil {
+				return nil, fmt.Errorf("invalid constraint %q: %w", s, err)
+			}
+			set = append(set, comparators...)
+		}
+		if len(set) == 0 && strings.Contains(s, "||") {
+			return nil, fmt.Errorf("invalid constraint %q: empty alternative", s)
+		}
+		c.sets = append(c.sets, set)
+	}
+	return c, nil
+}
+
+func parseTerm(term string) ([]comparator, error) {
+	op := ""
+	for _, candidate := range []string{">=", "<=", "!=", "==", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(term, candidate) {
+			op = candidate
+			break
+		}
+	}
+	v, parts, err := parsePartial(strings.TrimPrefix(term, op))
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "~":
+		// ~1.2.3 allows patch updates, ~1 allows minor updates.
+		upper := Version{Major: v.Major, Minor: v.Minor + 1}
+		if parts == 1 {
+			upper = Version{Major: v.Major + 1}
+		}
+		return []comparator{{">=", v}, {"<", upper}}, nil
+	case "^":
+		// ^1.2.3 allows everything up to the next breaking version, the left-most non zero part.
+		var upper Version
+		switch {
+		case v.Major > 0 || parts == 1:
+			upper = Version{Major: v.Major + 1}
+		case v.Minor > 0 || parts == 2:
+			upper = Version{Minor: v.Minor + 1}
+		default:
+			upper = Version{Patch: v.Patch + 1}
+		}
+		return []comparator{{">=", v}, {"<", upper}}, nil
+	case "", "=", "==":
+		if parts == 3 {
+			return []comparator{{"=", v}}, nil
+		}
+		// A partial version matches the whole range it names, =1.2 is >=1.2.0 <1.3.0.
+		upper := Version{Major: v.Major + 1}
+		if parts == 2 {
+			upper = Version{Major: v.Major, Minor: v.Minor + 1}
+		}
+		return []comparator{{">=", v}, {"<", upper}}, nil
+	default:
+		return []comparator{{op, v}}, nil
+	}
+}
+
+// Check reports whether v satisfies the constraint. Like npm, a prerelease only satisfies a set of
+// comparators when one of them names a prerelease of the same major.minor.patch, so <2.0.0 rejects
+// 2.0.0-beta.1 and >=2.0.0-beta.1 accepts 2.0.0-beta.2 but not 2.1.0-beta.1.
+func (c *Constraint) Check(v Version) bool {
+	for _, set := range c.sets {
+		if v.Prerelease != "" && len(set) > 0 && !allowsPrerelease(set, v) {
+			continue
+		}
+		ok := true
+		for _, cmp := range set {
+			if !cmp.check(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func allowsPrerelease(set []comparator, v Version) bool {
+	for _, cmp := range set {
+		if cmp.version.Prerelease != "" && cmp.version.Major == v.Major && cmp.version.Minor == v.Minor && cmp.version.Patch == v.Patch {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Constraint) String() string {
+	return c.raw
+}
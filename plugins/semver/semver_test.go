@@ -0,0 +1,74 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAndCompare(t *testing.T) {
+	v, err := Parse("v1.2.3-beta.2+build.5")
+	require.NoError(t, err)
+	assert.Equal(t, Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "beta.2"}, v)
+	assert.Equal(t, "1.2.3-beta.2", v.String())
+
+	for _, invalid := range []string{"", "1.2", "1.2.x", "1.2.3.4", "-1.0.0"} {
+		_, err := Parse(invalid)
+		assert.Error(t, err, invalid)
+	}
+
+	ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0", "1.0.1", "1.2.0", "2.0.0"}
+	for i := 1; i < len(ordered); i++ {
+		lower, _ := Parse(ordered[i-1])
+		higher, _ := Parse(ordered[i])
+		assert.Equal(t, -1, lower.Compare(higher), "%s < %s", lower, higher)
+		assert.Equal(t, 1, higher.Compare(lower), "%s > %s", higher, lower)
+	}
+}
+
+func TestConstraints(t *testing.T) {
+	tests := []struct {
+		constraint string
+		matches    []string
+		rejects    []string
+	}{
+		{">=1.2.0 <2", []string{"1.2.0", "1.9.9"}, []string{"1.1.9", "2.0.0"}},
+		{">= 1.2.0, < 2", []string{"1.5.0"}, []string{"2.1.0"}},
+		{"1.2", []string{"1.2.0", "1.2.9"}, []string{"1.3.0"}},
+		{"=1.2.3", []string{"1.2.3"}, []string{"1.2.4"}},
+		{"!=1.2.3", []string{"1.2.4"}, []string{"1.2.3"}},
+		{"~1.2.3", []string{"1.2.3", "1.2.9"}, []string{"1.3.0", "1.2.2"}},
+		{"~1", []string{"1.9.0"}, []string{"2.0.0"}},
+		{"^1.2.3", []string{"1.2.3", "1.9.0"}, []string{"2.0.0", "1.2.2"}},
+		{"^0.2.3", []string{"0.2.9"}, []string{"0.3.0"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4"}},
+		{"^1 || ^3", []string{"1.4.0", "3.0.0"}, []string{"2.0.0"}},
+		{"<2.0.0", []string{"1.9.9"}, []string{"2.0.0-beta.1", "1.5.0-rc.1"}},
+		{">=1.2.0 <2", nil, []string{"2.0.0-beta.1"}},
+		{">=2.0.0-beta.1", []string{"2.0.0-beta.2", "2.0.0", "2.1.0"}, []string{"2.0.0-alpha", "2.1.0-beta.1"}},
+		{"^2.0.0-rc.1", []string{"2.0.0-rc.2", "2.3.0"}, []string{"3.0.0-rc.1"}},
+		{"", []string{"0.0.1", "9.9.9", "1.0.0-beta"}, nil},
+	}
+	for _, test := range tests {
+		c, err := ParseConstraint(test.constraint)
+		require.NoError(t, err, test.constraint)
+		for _, s := range test.matches {
+			assert.True(t, c.Check(mustParse(t, s)), "%s should match %q", s, test.constraint)
+		}
+		for _, s := range test.rejects {
+			assert.False(t, c.Check(mustParse(t, s)), "%s should not match %q", s, test.constraint)
+		}
+	}
+
+	for _, invalid := range []string{">=x", "^1.2.3.4", ">=1 ||", "=>1"} {
+		_, err := ParseConstraint(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func mustParse(t *testing.T, s string) Version {
+	v, err := Parse(s)
+	require.NoError(t, err)
+	return v
+}
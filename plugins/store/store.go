@@ -0,0 +1,417 @@
+package store
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/theHamdiz/gost/config"
+	"github.com/theHamdiz/gost/dwn"
+	"github.com/theHamdiz/gost/plugins"
+	"github.com/theHamdiz/gost/plugins/loader"
+)
+
+// LockFile records the installed plugins inside the store root.
+const LockFile = "plugins.lock"
+
+// Entry is an installed plugin as recorded in the lockfile.
+type Entry struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	AuthorName   string   `json:"author_name,omitempty"`
+	Dependencies []string `json:"dependencies,omitempty"`
+	Source       string   `json:"source"`
+	Path         string   `json:"path"`
+	Executable   string   `json:"executable"`
+	Enabled      bool     `json:"enabled"`
+}
+
+type lock struct {
+	Plugins []Entry `json:"plugins"`
+}
+
+// Store keeps installed plugins under root, one directory per plugin name and version.
+type Store struct {
+	root string
+}
+
+// DefaultRoot returns ~/.gost/plugins.
+func DefaultRoot() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".gost", "plugins"), nil
+}
+
+// Open returns the store at root, it is created on the first install.
+func Open(root string) *Store {
+	return &Store{root: root}
+}
+
+// OpenDefault returns the store at DefaultRoot.
+func OpenDefault() (*Store, error) {
+	root, err := DefaultRoot()
+	if err != nil {
+		return nil, err
+	}
+	return Open(root), nil
+}
+
+// List returns the installed plugins sorted by name.
+func (s *Store) List() ([]Entry, error) {
+	l, err := s.readLock()
+	if err != nil {
+		return nil, err
+	}
+	return l.Plugins, nil
+}
+
+// Dir returns the absolute directory an entry is installed in.
+func (s *Store) Dir(entry Entry) string {
+	return filepath.Join(s.root, filepath.FromSlash(entry.Path))
+}
+
+// Install fetches source, a local directory, a .tar/.tar.gz/.tgz archive (local or http) or a git URL,
+// validates its metadata and copies it into the store, replacing any installed version of the same plugin.
+func (s *Store) Install(source string) (Entry, error) {
+	tmp, err := os.MkdirTemp("", "gost-plugin-")
+	if err != nil {
+		return Entry{}, err
+	}
+	defer os.RemoveAll(tmp)
+
+	if abs, err := filepath.Abs(source); err == nil && isLocal(source) {
+		source = abs
+	}
+	dir, err := fetch(source, tmp)
+	if err != nil {
+		return Entry{}, err
+	}
+	metaFile := loader.MetadataFile(dir)
+	if metaFile == "" {
+		return Entry{}, fmt.Errorf("%s is not a gost plugin, %s is missing", source, loader.MetadataFiles[0])
+	}
+	meta, err := loader.LoadPluginMetadata(metaFile)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	// Resolve the executable where it was fetched, the store directory is named after the version.
+	executable, err := loader.Executable(dir, meta)
+	if err != nil {
+		return Entry{}, err
+	}
+	if rel, err := filepath.Rel(dir, executable); err == nil && !strings.HasPrefix(rel, "..") {
+		executable = filepath.ToSlash(rel)
+	}
+
+	l, err := s.readLock()
+	if err != nil {
+		return Entry{}, err
+	}
+	entry := Entry{
+		Name:         meta.Name,
+		Version:      meta.Version,
+		AuthorName:   meta.AuthorName,
+		Dependencies: meta.Dependencies,
+		Source:       source,
+		Path:         meta.Name + "/" + meta.Version,
+		Executable:   executable,
+		Enabled:      true,
+	}
+	target := s.Dir(entry)
+	if err := os.RemoveAll(target); err != nil {
+		return Entry{}, err
+	}
+	if err := copyDir(dir, target); err != nil {
+		return Entry{}, err
+	}
+
+	if previous, i := l.find(meta.Name); i >= 0 {
+		entry.Enabled = previous.Enabled
+		if s.Dir(previous) != target {
+			if err := os.RemoveAll(s.Dir(previous)); err != nil {
+				return Entry{}, err
+			}
+		}
+		l.Plugins = append(l.Plugins[:i], l.Plugins[i+1:]...)
+	}
+	l.Plugins = append(l.Plugins, entry)
+	return entry, s.writeLock(l)
+}
+
+// Uninstall removes a plugin, refusing when another installed plugin depends on it.
+func (s *Store) Uninstall(name string) (Entry, error) {
+	l, err := s.readLock()
+	if err != nil {
+		return Entry{}, err
+	}
+	entry, i := l.find(name)
+	if i < 0 {
+		return Entry{}, fmt.Errorf("plugin %s is not installed", name)
+	}
+
+	var dependents []string
+	for _, other := range l.Plugins {
+		for _, raw := range other.Dependencies {
+			if dep, err := plugins.ParseDependency(raw); err == nil && dep.Name == entry.Name {
+				dependents = append(dependents, other.Name)
+			}
+		}
+	}
+	if len(dependents) > 0 {
+		return Entry{}, fmt.Errorf("cannot uninstall %s, it is required by %s", entry.Name, strings.Join(dependents, ", "))
+	}
+
+	if err := os.RemoveAll(s.Dir(entry)); err != nil {
+		return Entry{}, err
+	}
+	// Drop the plugin's directory too once its last version is gone.
+	_ = os.Remove(filepath.Dir(s.Dir(entry)))
+	l.Plugins = append(l.Plugins[:i], l.Plugins[i+1:]...)
+	return entry, s.writeLock(l)
+}
+
+// LoadEnabled loads the enabled plugins as out-of-process plugins for data.
+func (s *Store) LoadEnabled(data config.ProjectData) ([]plugins.Plugin, error) {
+	entries, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	var loaded []plugins.Plugin
+	for _, entry := range entries {
+		if !entry.Enabled {
+			continue
+		}
+		plugin, err := s.load(entry, data)
+		if err != nil {
+			return nil, fmt.Errorf("installed plugin %s is broken, reinstall it: %w", entry.Name, err)
+		}
+		loaded = append(loaded, plugin)
+	}
+	return loaded, nil
+}
+
+func (s *Store) load(entry Entry, data config.ProjectData) (plugins.Plugin, error) {
+	dir := s.Dir(entry)
+	meta, err := loader.LoadPluginMetadata(loader.MetadataFile(dir))
+	if err != nil {
+		return nil, err
+	}
+	meta.Executable = filepath.FromSlash(entry.Executable)
+	executable, err := loader.Executable(dir, meta)
+	if err != nil {
+		return nil, err
+	}
+	return plugins.NewProcessPlugin(*meta, executable, dir, data), nil
+}
+
+// find returns the entry named name, ignoring case, and its index or -1.
+func (l *lock) find(name string) (Entry, int) {
+	for i, entry := range l.Plugins {
+		if strings.EqualFold(entry.Name, name) {
+			return entry, i
+		}
+	}
+	return Entry{}, -1
+}
+
+func (s *Store) readLock() (*lock, error) {
+	data, err := os.ReadFile(filepath.Join(s.root, LockFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return &lock{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var l lock
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, fmt.Errorf("invalid plugin lockfile %s: %w", filepath.Join(s.root, LockFile), err)
+	}
+	return &l, nil
+}
+
+func (s *Store) writeLock(l *lock) error {
+	sort.Slice(l.Plugins, func(i, j int) bool { return l.Plugins[i].Name < l.Plugins[j].Name })
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.root, 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(s.root, LockFile), append(data, '\n'), 0644)
+}
+
+// fetch makes source available as a local directory, downloading or extracting it into tmp when needed.
+func fetch(source, tmp string) (string, error) {
+	if info, err := os.Stat(source); err == nil && info.IsDir() {
+		return source, nil
+	}
+
+	if ext := archiveExt(source); ext != "" {
+		archive := source
+		if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+			archive = filepath.Join(tmp, "plugin"+ext)
+			if err := dwn.DownloadFile(source, archive); err != nil {
+				return "", fmt.Errorf("could not download %s: %w", source, err)
+			}
+		}
+		dir := filepath.Join(tmp, sourceName(source))
+		if err := extract(archive, dir); err != nil {
+			return "", fmt.Errorf("could not extract %s: %w", source, err)
+		}
+		return singleSubdir(dir), nil
+	}
+
+	if !isLocal(source) {
+		dir := filepath.Join(tmp, sourceName(source))
+		cmd := exec.Command("git", "clone", "--depth", "1", source, dir)
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("could not clone %s: %w", source, err)
+		}
+		return dir, nil
+	}
+
+	return "", fmt.Errorf("%s is not a directory, tarball or git URL", source)
+}
+
+// sourceName returns the folder name a fetched source is placed in, so the executable defaults to
+// the repository or tarball name, e.g. audit for https://github.com/acme/audit.git or audit.tar.gz.
+func sourceName(source string) string {
+	name := source[strings.LastIndexAny(source, `/\:`)+1:]
+	if ext := archiveExt(name); ext != "" {
+		name = name[:len(name)-len(ext)]
+	}
+	name = strings.TrimSuffix(name, ".git")
+	if name == "" || name == "." || name == ".." {
+		return "plugin"
+	}
+	return name
+}
+
+// isLocal reports whether source is a path rather than a URL.
+func isLocal(source string) bool {
+	return !strings.Contains(source, "://") && !strings.HasPrefix(source, "git@")
+}
+
+// archiveExt returns the tarball extension of source, or an empty string when it is not a tarball.
+func archiveExt(source string) string {
+	for _, ext := range []string{".tar", ".tar.gz", ".tgz"} {
+		if strings.HasSuffix(strings.ToLower(source), ext) {
+			return ext
+		}
+	}
+	return ""
+}
+
+// singleSubdir returns the only directory inside dir, archives usually wrap the plugin in one.
+func singleSubdir(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 1 || !entries[0].IsDir() || loader.MetadataFile(dir) != "" {
+		return dir
+	}
+	return filepath.Join(dir, entries[0].Name())
+}
+
+func extract(archive, dir string) error {
+	file, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if !strings.HasSuffix(strings.ToLower(archive), ".tar") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("%s points outside the archive", header.Name)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := writeFile(target, tr, fs.FileMode(header.Mode).Perm()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// copyDir copies src into dst keeping file permissions, version control folders are skipped.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		return writeFile(target, file, info.Mode().Perm())
+	})
+}
+
+func writeFile(target string, r io.Reader, perm fs.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,146 @@
+package store
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theHamdiz/gost/config"
+)
+
+func writePlugin(t *testing.T, dir, name, meta string) string {
+	t.Helper()
+	pluginDir := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(pluginDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "plugin.yaml"), []byte(meta), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, name), []byte("#!/bin/sh\n"), 0755))
+	return pluginDir
+}
+
+func writeTarball(t *testing.T, src, archive string) {
+	t.Helper()
+	file, err := os.Create(archive)
+	require.NoError(t, err)
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		require.NoError(t, err)
+		rel, _ := filepath.Rel(filepath.Dir(src), path)
+		header, err := tar.FileInfoHeader(info, "")
+		require.NoError(t, err)
+		header.Name = filepath.ToSlash(rel)
+		require.NoError(t, tw.WriteHeader(header))
+		if !info.IsDir() {
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			_, err = tw.Write(data)
+			require.NoError(t, err)
+		}
+		return nil
+	}))
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	require.NoError(t, file.Close())
+}
+
+func TestInstallListUninstall(t *testing.T) {
+	src := t.TempDir()
+	s := Open(filepath.Join(t.TempDir(), "plugins"))
+
+	audit := writePlugin(t, src, "audit", "name: AuditPlugin\nversion: 1.0.0\nauthor_name: Acme\n")
+	entry, err := s.Install(audit)
+	require.NoError(t, err)
+	assert.Equal(t, "AuditPlugin/1.0.0", entry.Path)
+	assert.Equal(t, audit, entry.Source)
+	assert.FileExists(t, filepath.Join(s.Dir(entry), "audit"))
+
+	reports := writePlugin(t, t.TempDir(), "reports", "name: ReportsPlugin\nversion: 0.3.0\ndependencies: [AuditPlugin>=1]\n")
+	archive := filepath.Join(t.TempDir(), "reports.tar.gz")
+	writeTarball(t, reports, archive)
+	_, err = s.Install(archive)
+	require.NoError(t, err)
+
+	// Upgrading replaces the installed version.
+	require.NoError(t, os.WriteFile(filepath.Join(audit, "plugin.yaml"), []byte("name: AuditPlugin\nversion: 1.1.0\n"), 0644))
+	upgraded, err := s.Install(audit)
+	require.NoError(t, err)
+	assert.NoDirExists(t, s.Dir(entry))
+	assert.DirExists(t, s.Dir(upgraded))
+
+	entries, err := s.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "AuditPlugin", entries[0].Name)
+	assert.Equal(t, "1.1.0", entries[0].Version)
+	assert.Equal(t, []string{"AuditPlugin>=1"}, entries[1].Dependencies)
+	assert.True(t, entries[1].Enabled)
+
+	loaded, err := s.LoadEnabled(config.ProjectData{})
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+	assert.Equal(t, "ReportsPlugin", loaded[1].Name())
+	assert.Equal(t, "reports", entries[1].Executable)
+
+	_, err = s.Uninstall("AuditPlugin")
+	assert.EqualError(t, err, "cannot uninstall AuditPlugin, it is required by ReportsPlugin")
+	_, err = s.Uninstall("reportsplugin")
+	require.NoError(t, err)
+	_, err = s.Uninstall("AuditPlugin")
+	require.NoError(t, err)
+	assert.NoDirExists(t, filepath.Join(s.root, "AuditPlugin"))
+
+	entries, err = s.List()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	_, err = s.Uninstall("AuditPlugin")
+	assert.EqualError(t, err, "plugin AuditPlugin is not installed")
+}
+
+func TestInstallRejectsInvalidPlugins(t *testing.T) {
+	src := t.TempDir()
+	s := Open(filepath.Join(t.TempDir(), "plugins"))
+
+	_, err := s.Install(filepath.Join(src, "missing"))
+	assert.ErrorContains(t, err, "is not a directory, tarball or git URL")
+
+	_, err = s.Install(src)
+	assert.ErrorContains(t, err, "plugin.yaml is missing")
+
+	invalid := writePlugin(t, src, "invalid", "name: ../escape\nversion: 1\n")
+	_, err = s.Install(invalid)
+	assert.ErrorContains(t, err, "invalid plugin name")
+	assert.ErrorContains(t, err, `invalid version "1"`)
+
+	noExecutable := writePlugin(t, src, "noexec", "name: NoExec\nversion: 1.0.0\nexecutable: bin/run\n")
+	_, err = s.Install(noExecutable)
+	assert.ErrorContains(t, err, "not found")
+	assert.NoDirExists(t, filepath.Join(s.root, "NoExec", "1.0.0"))
+}
+
+func TestInstallFromGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	repo := writePlugin(t, t.TempDir(), "audit", "name: AuditPlugin\nversion: 2.0.0\n")
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "."},
+		{"-c", "user.name=gost", "-c", "user.email=gost@example.com", "commit", "-q", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	s := Open(filepath.Join(t.TempDir(), "plugins"))
+	entry, err := s.Install("file://" + filepath.ToSlash(repo))
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", entry.Version)
+	assert.NoDirExists(t, filepath.Join(s.Dir(entry), ".git"))
+}
@@ -0,0 +1,155 @@
+package project
+
+import (
+	"bufio"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/theHamdiz/gost/config"
+	"github.com/theHamdiz/gost/dialect"
+)
+
+// configFiles are the config files a generated project may carry, see codegen/files.
+var configFiles = []string{".env", "config.json", "config.toml", "config.yaml"}
+
+// settingLine matches KEY=value, KEY = "value", KEY: "value" and "KEY": "value" lines.
+var settingLine = regexp.MustCompile(`^\s*"?([A-Z0-9_]+)"?\s*[=:]\s*"?([^"]*?)"?\s*,?\s*$`)
+
+// FindRoot walks up from dir until it finds the directory holding the project's go.mod.
+func FindRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errors.New(">>Gost>> not inside a gost project, could not find a go.mod")
+		}
+		dir = parent
+	}
+}
+
+// Load rebuilds the ProjectData of an existing gost project from its go.mod and generated config file.
+func Load(dir string) (*config.ProjectData, error) {
+	root, err := FindRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	module, err := moduleName(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+
+	settings := Settings(root)
+	data := &config.ProjectData{
+		AppName:       module,
+		ProjectDir:    root,
+		DbDriver:      settings["DB_DRIVER"],
+		BackendPkg:    settings["GOST_BACKEND"],
+		MigrationsDir: settings["MIGRATIONS_DIR"],
+	}
+	if data.MigrationsDir == "" {
+		data.MigrationsDir = "app/db/migrations"
+	}
+	if port, err := strconv.Atoi(strings.TrimPrefix(settings["PORT"], ":")); err == nil {
+		data.Port = port
+	}
+	return data, nil
+}
+
+// DbPath returns the path of the project's sqlite database file.
+func DbPath(data *config.ProjectData) string {
+	return filepath.Join(data.ProjectDir, "app", "db", "data.db")
+}
+
+// OpenDB opens the project's database using the dialect of its DbDriver.
+// Sqlite projects use app/db/data.db, other dialects use DATABASE_URL or the DB_* settings.
+func OpenDB(data *config.ProjectData) (*sql.DB, *dialect.Dialect, error) {
+	d, err := dialect.For(data.DbDriver)
+	if err != nil {
+		return nil, nil, err
+	}
+	if d.Name == "sqlite" {
+		if err := os.MkdirAll(filepath.Dir(DbPath(data)), 0755); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	db, err := sql.Open(d.DriverName, DSN(data, d))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, nil, fmt.Errorf(">>Gost>> could not connect to the %s database: %w", d.Name, err)
+	}
+	return db, d, nil
+}
+
+// DSN builds the data source name used to connect to the project's database.
+func DSN(data *config.ProjectData, d *dialect.Dialect) string {
+	settings := Settings(data.ProjectDir)
+	if url := settings["DATABASE_URL"]; url != "" && d.Name != "sqlite" {
+		return url
+	}
+	user, password, host, name := settings["DB_USER"], settings["DB_PASSWORD"], settings["DB_HOST"], settings["DB_NAME"]
+	switch d.Name {
+	case "postgresql":
+		if host == "" {
+			host = "localhost:5432"
+		}
+		return fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, password, host, name)
+	case "mysql":
+		if host == "" {
+			host = "localhost:3306"
+		}
+		return fmt.Sprintf("%s:%s@tcp(%s)/%s?parseTime=true&multiStatements=true", user, password, host, name)
+	default:
+		return "file:" + DbPath(data) + "?_pragma=foreign_keys(1)"
+	}
+}
+
+// Settings reads the flat KEY/value settings of the first config file found in the project root.
+func Settings(root string) map[string]string {
+	settings := make(map[string]string)
+	for _, name := range configFiles {
+		file, err := os.Open(filepath.Join(root, name))
+		if err != nil {
+			continue
+		}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if m := settingLine.FindStringSubmatch(scanner.Text()); m != nil {
+				settings[m[1]] = m[2]
+			}
+		}
+		_ = file.Close()
+		break
+	}
+	return settings
+}
+
+func moduleName(goMod string) (string, error) {
+	content, err := os.ReadFile(goMod)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "module ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "module ")), nil
+		}
+	}
+	return "", fmt.Errorf(">>Gost>> no module declaration found in %s", goMod)
+}
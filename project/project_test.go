@@ -0,0 +1,150 @@
+package project
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theHamdiz/gost/config"
+	"github.com/theHamdiz/gost/dialect"
+)
+
+// configs are the config files of each format as codegen/files generates them.
+var configs = map[string]string{
+	".env": `
+# Application environment
+GOST_ENV=DEV
+PORT=:8080
+DB_DRIVER=postgres
+DB_USER=gost
+DB_HOST=db:5432
+DB_PASSWORD=secret
+DB_NAME=blog
+MIGRATIONS_DIR=db/migrations
+GOST_BACKEND=chi
+`,
+	"config.json": `{
+  ".gost.env": {
+    "GOST_ENV": "DEV",
+    "PORT": ":8080",
+    "DB_DRIVER": "postgres",
+    "DB_USER": "gost",
+    "DB_HOST": "db:5432",
+    "DB_PASSWORD": "secret",
+    "DB_NAME": "blog",
+    "MIGRATIONS_DIR": "db/migrations",
+    "GOST_BACKEND": "chi"
+  }
+}
+`,
+	"config.toml": `
+[gost.env]
+GOST_ENV = "DEV"
+PORT = ":8080"
+DB_DRIVER = "postgres"
+DB_USER = "gost"
+DB_HOST = "db:5432"
+DB_PASSWORD = "secret"
+DB_NAME = "blog"
+MIGRATIONS_DIR = "db/migrations"
+GOST_BACKEND = "chi"
+`,
+	"config.yaml": `
+GOST_ENV: DEV
+PORT: ":8080"
+DB_DRIVER: "postgres"
+DB_USER: "gost"
+DB_HOST: "db:5432"
+DB_PASSWORD: "secret"
+DB_NAME: "blog"
+MIGRATIONS_DIR: "db/migrations"
+GOST_BACKEND: "chi"
+`,
+}
+
+func writeProject(t *testing.T, files map[string]string) string {
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return root
+}
+
+func TestLoad(t *testing.T) {
+	for name, content := range configs {
+		t.Run(name, func(t *testing.T) {
+			root := writeProject(t, map[string]string{"go.mod": "module blog\n\ngo 1.22\n", name: content, "app/handlers/.keep": ""})
+
+			settings := Settings(root)
+			assert.Equal(t, "DEV", settings["GOST_ENV"])
+			assert.Equal(t, "secret", settings["DB_PASSWORD"])
+
+			data, err := Load(filepath.Join(root, "app", "handlers"))
+			require.NoError(t, err)
+			assert.Equal(t, &config.ProjectData{
+				AppName:       "blog",
+				ProjectDir:    root,
+				DbDriver:      "postgres",
+				BackendPkg:    "chi",
+				MigrationsDir: "db/migrations",
+				Port:          8080,
+			}, data)
+
+			d, err := dialect.For(data.DbDriver)
+			require.NoError(t, err)
+			assert.Equal(t, "postgres://gost:secret@db:5432/blog?sslmode=disable", DSN(data, d))
+		})
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	root := writeProject(t, map[string]string{"go.mod": "module blog\n"})
+
+	data, err := Load(root)
+	require.NoError(t, err)
+	assert.Empty(t, Settings(root))
+	assert.Equal(t, "app/db/migrations", data.MigrationsDir)
+	assert.Zero(t, data.Port)
+
+	d, err := dialect.For(data.DbDriver)
+	require.NoError(t, err)
+	assert.Equal(t, "file:"+filepath.Join(root, "app", "db", "data.db")+"?_pragma=foreign_keys(1)", DSN(data, d))
+}
+
+func TestDSN(t *testing.T) {
+	tests := []struct {
+		name     string
+		driver   string
+		settings string
+		want     string
+	}{
+		{"postgres defaults", "postgres", "DB_NAME=blog\n", "postgres://:@localhost:5432/blog?sslmode=disable"},
+		{"mysql", "mysql", "DB_USER=gost\nDB_PASSWORD=secret\nDB_NAME=blog\n", "gost:secret@tcp(localhost:3306)/blog?parseTime=true&multiStatements=true"},
+		{"database url", "postgres", "DATABASE_URL=postgres://remote/blog\nDB_NAME=ignored\n", "postgres://remote/blog"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := writeProject(t, map[string]string{"go.mod": "module blog\n", ".env": tt.settings})
+			d, err := dialect.For(tt.driver)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, DSN(&config.ProjectData{ProjectDir: root, DbDriver: tt.driver}, d))
+		})
+	}
+}
+
+func TestMissingRoot(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := FindRoot(dir)
+	assert.EqualError(t, err, ">>Gost>> not inside a gost project, could not find a go.mod")
+	_, err = Load(dir)
+	assert.Error(t, err)
+
+	root := writeProject(t, map[string]string{"go.mod": "go 1.22\n"})
+	_, err = Load(root)
+	assert.ErrorContains(t, err, "no module declaration found")
+}
@@ -1,37 +1,240 @@
 package seeder
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
+	"github.com/theHamdiz/gost/dialect"
+	"github.com/theHamdiz/gost/project"
 	"github.com/theHamdiz/gost/router"
-	"github.com/theHamdiz/gost/runner"
+	"gopkg.in/yaml.v3"
 )
 
+// SeedsDir is where user defined seed files live inside a project.
+const SeedsDir = "app/db/seeds"
+
+// DbInit creates the sqlite database of a freshly generated project and runs the seeding script against it.
 func DbInit(appName string) error {
-	script := GetSeedingScript()
+	dbPath, err := router.GetDbPath(strings.ToLower(appName))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0755); err != nil {
+		return err
+	}
 
-	doneMessage := `Database setup complete. Framework database has been created and populated with initial data.
-	`
+	d, err := dialect.For("sqlite")
+	if err != nil {
+		return err
+	}
+	db, err := sql.Open(d.DriverName, dbPath)
+	if err != nil {
+		return err
+	}
+	defer closeDB(db)
 
-	db, err := router.GetDbPath(appName)
+	if err := RunScript(db, d, "seeding script", GetSeedingScript()); err != nil {
+		return err
+	}
 
+	fmt.Println("Database setup complete. Framework database has been created and populated with initial data.")
+	return nil
+}
+
+// RunScript executes a SQL script statement by statement inside a single transaction,
+// reporting which statement failed.
+func RunScript(db *sql.DB, d *dialect.Dialect, name, script string) error {
+	tx, err := db.Begin()
 	if err != nil {
 		return err
 	}
+	for i, statement := range d.SplitStatements(script) {
+		if _, err := tx.Exec(statement); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf(">>Gost>> %s failed at statement %d: %w\n%s", name, i+1, err, statement)
+		}
+	}
+	return tx.Commit()
+}
+
+// Seeder loads the seed files of a project into its database.
+type Seeder struct {
+	db      *sql.DB
+	dialect *dialect.Dialect
+}
+
+func NewSeeder(db *sql.DB, d *dialect.Dialect) *Seeder {
+	return &Seeder{
+		db:      db,
+		dialect: d,
+	}
+}
 
-	err = runner.RunCommand("sqlite3", db, script)
+// SeedFiles returns the .sql, .json, .yaml and .yml seed files of dir sorted by file name,
+// prefix them with a number (01_users.yaml, 02_posts.sql) to control the order. A missing dir has no seed files.
+func SeedFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
 	if err != nil {
-		fmt.Println(err)
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".sql", ".json", ".yaml", ".yml":
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
 	}
-	err = runner.RunCommand("echo", doneMessage)
+	sort.Strings(files)
+	return files, nil
+}
+
+// Seed loads every seed file of dir in order and returns the files that were loaded.
+func (s *Seeder) Seed(dir string) ([]string, error) {
+	files, err := SeedFiles(dir)
 	if err != nil {
-		fmt.Println(err)
+		return nil, err
 	}
-	return nil
+	var seeded []string
+	for _, file := range files {
+		if err := s.SeedFile(file); err != nil {
+			return seeded, err
+		}
+		seeded = append(seeded, file)
+	}
+	return seeded, nil
+}
+
+// SeedFile loads a single SQL script or JSON/YAML fixture.
+func (s *Seeder) SeedFile(file string) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	name := filepath.Base(file)
+	if strings.EqualFold(filepath.Ext(file), ".sql") {
+		return RunScript(s.db, s.dialect, name, string(content))
+	}
+
+	fixtures, err := ParseFixtures(content)
+	if err != nil {
+		return fmt.Errorf(">>Gost>> invalid fixture %s: %w", name, err)
+	}
+	return s.insertFixtures(name, fixtures)
 }
 
-func SeedDBData(appName string) error {
-	return DbInit(appName)
+// Fixture holds the rows to insert into a table, columns keep the order they were written in.
+type Fixture struct {
+	Table   string
+	Columns []string
+	Rows    []map[string]interface{}
+}
+
+// ParseFixtures parses a JSON or YAML document mapping table names to lists of rows, e.g.
+//
+//	users:
+//	  - name: admin
+//	    email: admin@go.dev
+//
+// Tables are returned in the order they appear in the document.
+func ParseFixtures(content []byte) ([]Fixture, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("expected a mapping of table names to rows")
+	}
+
+	var fixtures []Fixture
+	for i := 0; i < len(root.Content); i += 2 {
+		table, rows := root.Content[i].Value, root.Content[i+1]
+		if rows.Kind != yaml.SequenceNode {
+			return nil, fmt.Errorf("table %s: expected a list of rows", table)
+		}
+		fixture := Fixture{Table: table}
+		seen := make(map[string]bool)
+		for _, rowNode := range rows.Content {
+			if rowNode.Kind != yaml.MappingNode {
+				return nil, fmt.Errorf("table %s: expected every row to be a mapping of columns to values", table)
+			}
+			row := make(map[string]interface{})
+			if err := rowNode.Decode(&row); err != nil {
+				return nil, fmt.Errorf("table %s: %w", table, err)
+			}
+			for j := 0; j < len(rowNode.Content); j += 2 {
+				column := rowNode.Content[j].Value
+				if !seen[column] {
+					seen[column] = true
+					fixture.Columns = append(fixture.Columns, column)
+				}
+			}
+			fixture.Rows = append(fixture.Rows, row)
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, nil
+}
+
+func (s *Seeder) insertFixtures(name string, fixtures []Fixture) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, fixture := range fixtures {
+		for i, row := range fixture.Rows {
+			var columns []string
+			var values []interface{}
+			for _, column := range fixture.Columns {
+				if value, ok := row[column]; ok {
+					columns = append(columns, column)
+					values = append(values, value)
+				}
+			}
+			query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", fixture.Table, strings.Join(columns, ", "), s.dialect.Placeholders(len(values)))
+			if _, err := tx.Exec(query, values...); err != nil {
+				_ = tx.Rollback()
+				return fmt.Errorf(">>Gost>> %s failed at %s row %d: %w", name, fixture.Table, i+1, err)
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// SeedDBData loads the seed files of the gost project found at projectDir into its database.
+func SeedDBData(projectDir string) ([]string, error) {
+	data, err := project.Load(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	db, d, err := project.OpenDB(data)
+	if err != nil {
+		return nil, err
+	}
+	defer closeDB(db)
+
+	return NewSeeder(db, d).Seed(filepath.Join(data.ProjectDir, SeedsDir))
+}
+
+func closeDB(db *sql.DB) {
+	if err := db.Close(); err != nil {
+		fmt.Println(">>Gost>> Error closing database:", err)
+	}
 }
 
 func GetSeedingScript() string {
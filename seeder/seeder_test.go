@@ -1 +1,101 @@
 package seeder
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theHamdiz/gost/dialect"
+)
+
+func TestSeedingScriptRunsStatementByStatement(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "data.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	d, _ := dialect.For("sqlite")
+
+	assert.NoError(t, RunScript(db, d, "seeding script", GetSeedingScript()))
+
+	var users int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM users").Scan(&users))
+	assert.Equal(t, 1, users)
+
+	err = RunScript(db, d, "broken.sql", "INSERT INTO settings (key, value) VALUES ('a', 'b');\nINSERT INTO nowhere VALUES (1);")
+	assert.ErrorContains(t, err, "broken.sql failed at statement 2")
+}
+
+func TestRunScriptWithTrigger(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "data.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	d, _ := dialect.For("sqlite")
+
+	require.NoError(t, RunScript(db, d, "trigger.sql", `
+CREATE TABLE posts (id INTEGER PRIMARY KEY, title TEXT);
+CREATE TABLE audit (post_id INTEGER, kind TEXT);
+CREATE TRIGGER audit_posts AFTER INSERT ON posts
+BEGIN
+    INSERT INTO audit (post_id, kind) VALUES (NEW.id, 'insert');
+    INSERT INTO audit (post_id, kind) VALUES (NEW.id, CASE WHEN NEW.title = '' THEN 'untitled' ELSE 'titled' END);
+END;
+INSERT INTO posts (title) VALUES ('Hello');
+`))
+
+	var entries int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM audit").Scan(&entries))
+	assert.Equal(t, 2, entries)
+}
+
+func TestSeedLoadsFilesInOrder(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	write("01_schema.sql", "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT, email TEXT);\nCREATE TABLE posts (id INTEGER PRIMARY KEY, title TEXT, user_id INTEGER);")
+	write("02_users.yaml", "users:\n  - name: admin\n    email: admin@go.dev\n  - name: guest\n")
+	write("03_posts.json", `{"posts": [{"title": "Hello", "user_id": 1}]}`)
+	write("README.md", "ignored")
+
+	db, err := sql.Open("sqlite", filepath.Join(dir, "data.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	d, _ := dialect.For("sqlite")
+
+	seeded, err := NewSeeder(db, d).Seed(dir)
+	assert.NoError(t, err)
+	assert.Len(t, seeded, 3)
+	assert.Equal(t, "01_schema.sql", filepath.Base(seeded[0]))
+
+	var email sql.NullString
+	require.NoError(t, db.QueryRow("SELECT email FROM users WHERE name = 'guest'").Scan(&email))
+	assert.False(t, email.Valid)
+
+	var title string
+	require.NoError(t, db.QueryRow("SELECT title FROM posts WHERE user_id = 1").Scan(&title))
+	assert.Equal(t, "Hello", title)
+}
+
+func TestSeedWithoutSeedsDir(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "data.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	d, _ := dialect.For("sqlite")
+
+	seeded, err := NewSeeder(db, d).Seed(filepath.Join(t.TempDir(), SeedsDir))
+	assert.NoError(t, err)
+	assert.Empty(t, seeded)
+}
+
+func TestParseFixturesKeepsDocumentOrder(t *testing.T) {
+	fixtures, err := ParseFixtures([]byte("users:\n  - name: a\n    email: b\nposts:\n  - title: c\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "users", fixtures[0].Table)
+	assert.Equal(t, []string{"name", "email"}, fixtures[0].Columns)
+	assert.Equal(t, "posts", fixtures[1].Table)
+
+	_, err = ParseFixtures([]byte("- just a list"))
+	assert.Error(t, err)
+}